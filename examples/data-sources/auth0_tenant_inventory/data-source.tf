@@ -0,0 +1,13 @@
+# All the objects of the tenant.
+data "auth0_tenant_inventory" "all" {}
+
+# Only the clients and connections of the tenant.
+data "auth0_tenant_inventory" "clients_and_connections" {
+  types       = ["client", "connection"]
+  parallelism = 8
+}
+
+# The import IDs of the objects, to compare with `terraform state list`.
+output "tenant_objects" {
+  value = [for object in data.auth0_tenant_inventory.all.objects : "${object.resource_type} ${object.id} (${object.name})"]
+}
@@ -0,0 +1,41 @@
+# The following example gets an access token for a machine to machine
+# client and uses it to configure another provider, without storing it
+# in the plan or state.
+# NOTE: Ephemeral resources are supported in Terraform 1.10 and later.
+
+resource "auth0_resource_server" "my_api" {
+  name       = "Example API (Managed by Terraform)"
+  identifier = "https://api.example.com"
+}
+
+resource "auth0_client" "my_client" {
+  name     = "Example Machine to Machine Application (Managed by Terraform)"
+  app_type = "non_interactive"
+}
+
+resource "auth0_client_credentials" "my_client_credentials" {
+  client_id = auth0_client.my_client.id
+
+  authentication_method = "client_secret_post"
+}
+
+resource "auth0_client_grant" "my_client_grant" {
+  client_id = auth0_client.my_client.id
+  audience  = auth0_resource_server.my_api.identifier
+  scopes    = []
+}
+
+ephemeral "auth0_client_credentials_token" "my_token" {
+  client_id     = auth0_client.my_client.client_id
+  client_secret = auth0_client_credentials.my_client_credentials.client_secret
+  audience      = auth0_resource_server.my_api.identifier
+
+  depends_on = [auth0_client_grant.my_client_grant]
+}
+
+provider "restapi" {
+  uri = "https://api.example.com"
+  headers = {
+    Authorization = "Bearer ${ephemeral.auth0_client_credentials_token.my_token.access_token}"
+  }
+}
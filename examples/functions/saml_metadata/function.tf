@@ -0,0 +1,20 @@
+# The following example creates a SAML connection from the metadata
+# published by the identity provider of an enterprise customer.
+# NOTE: Provider-defined functions are supported in Terraform 1.8 and later.
+
+locals {
+  idp = provider::auth0::saml_metadata(file("${path.module}/idp-metadata.xml"))
+}
+
+resource "auth0_connection" "samlp" {
+  name     = "Customer-SAML-Connection"
+  strategy = "samlp"
+
+  options {
+    signing_cert      = local.idp.signing_cert
+    sign_in_endpoint  = local.idp.sign_in_endpoint
+    sign_out_endpoint = local.idp.sign_out_endpoint
+    protocol_binding  = local.idp.protocol_binding
+    entity_id         = local.idp.entity_id
+  }
+}
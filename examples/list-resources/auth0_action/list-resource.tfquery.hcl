@@ -0,0 +1,13 @@
+# The following example finds the deployed actions of the post-login trigger.
+# Run `terraform query -generate-config-out=generated.tf` to generate
+# the configuration and import blocks of each of them.
+# NOTE: List resources are supported in Terraform 1.14 and later.
+
+list "auth0_action" "post_login" {
+  provider = auth0
+
+  config {
+    trigger_id = "post-login"
+    deployed   = true
+  }
+}
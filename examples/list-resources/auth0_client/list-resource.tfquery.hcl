@@ -0,0 +1,13 @@
+# The following example finds the single page applications whose name contains "Acme".
+# Run `terraform query -generate-config-out=generated.tf` to generate
+# the configuration and import blocks of each of them.
+# NOTE: List resources are supported in Terraform 1.14 and later.
+
+list "auth0_client" "spas" {
+  provider = auth0
+
+  config {
+    name_filter = "Acme"
+    app_types   = ["spa"]
+  }
+}
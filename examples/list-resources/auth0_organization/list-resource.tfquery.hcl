@@ -0,0 +1,12 @@
+# The following example finds the organizations whose name contains "acme".
+# Run `terraform query -generate-config-out=generated.tf` to generate
+# the configuration and import blocks of each of them.
+# NOTE: List resources are supported in Terraform 1.14 and later.
+
+list "auth0_organization" "acme" {
+  provider = auth0
+
+  config {
+    name_filter = "acme"
+  }
+}
@@ -0,0 +1,12 @@
+# The following example finds the users with an example.com email address.
+# Run `terraform query -generate-config-out=generated.tf` to generate
+# the configuration and import blocks of each of them.
+# NOTE: List resources are supported in Terraform 1.14 and later.
+
+list "auth0_user" "example" {
+  provider = auth0
+
+  config {
+    query = "email.domain:\"example.com\""
+  }
+}
@@ -0,0 +1,2 @@
+AUTH0_API_USAGE_REPORT="auth0-api-usage.json" \
+terraform plan
\ No newline at end of file
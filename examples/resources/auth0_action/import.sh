@@ -2,3 +2,9 @@
 #
 # Example:
 terraform import auth0_action.my_action "12f4f21b-017a-319d-92e7-2291c1ca36c4"
+#
+# It can also be imported by specifying the action name, prefixed by "name:".
+# The import fails when several actions share the same name.
+#
+# Example:
+terraform import auth0_action.my_action "name:my-action"
@@ -2,3 +2,9 @@
 #
 # Example:
 terraform import auth0_client.my_client "AaiyAPdpYdesoKnqjj8HJqRn4T5titww"
+#
+# It can also be imported by specifying the client name, prefixed by "name:".
+# The import fails when several clients share the same name.
+#
+# Example:
+terraform import auth0_client.my_client "name:My App"
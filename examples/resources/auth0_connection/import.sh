@@ -2,3 +2,9 @@
 #
 # Example:
 terraform import auth0_connection.google "con_a17f21fdb24d48a0"
+#
+# It can also be imported by specifying the connection name, prefixed by "name:".
+# The import fails when several connections share the same name.
+#
+# Example:
+terraform import auth0_connection.google "name:google-oauth2"
@@ -0,0 +1,7 @@
+import {
+  to = auth0_connection_client.my_conn_client_assoc
+  identity = {
+    connection_id = "con_XXXXX"
+    client_id     = "XXXXXXXX"
+  }
+}
@@ -4,3 +4,8 @@
 #
 # Example:
 terraform import auth0_connection_client.my_conn_client_assoc "con_XXXXX::XXXXXXXX"
+#
+# The connection and client can also be specified by name, prefixed by "name:".
+#
+# Example:
+terraform import auth0_connection_client.my_conn_client_assoc "name:google-oauth2::name:My App"
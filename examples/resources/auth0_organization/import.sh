@@ -2,3 +2,9 @@
 #
 # Example:
 terraform import auth0_organization.my_organization "org_XXXXXXXXXXXXXX"
+#
+# It can also be imported by specifying the organization name, prefixed by "name:".
+# The import fails when several organizations share the same name.
+#
+# Example:
+terraform import auth0_organization.my_organization "name:acme"
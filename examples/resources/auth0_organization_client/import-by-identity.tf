@@ -0,0 +1,7 @@
+import {
+  to = auth0_organization_client.my_org_client
+  identity = {
+    organization_id = "org_XXXXX"
+    client_id       = "clientXXXXX"
+  }
+}
@@ -4,3 +4,8 @@
 #
 # Example:
 terraform import auth0_organization_client.my_org_client "org_XXXXX::clientXXXXX"
+#
+# The organization and client can also be specified by name, prefixed by "name:".
+#
+# Example:
+terraform import auth0_organization_client.my_org_client "name:acme::name:My App"
@@ -0,0 +1,7 @@
+import {
+  to = auth0_organization_client_grant.my_org_client_grant
+  identity = {
+    organization_id = "org_XXXXX"
+    grant_id        = "cgr_XXXXX"
+  }
+}
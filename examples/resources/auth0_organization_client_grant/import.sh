@@ -4,3 +4,8 @@
 #
 # Example:
 terraform import auth0_organization_client_grant.my_org_client_grant "org_XXXXX::cgr_XXXXX"
+#
+# The organization can also be specified by name, prefixed by "name:".
+#
+# Example:
+terraform import auth0_organization_client_grant.my_org_client_grant "name:acme::cgr_XXXXX"
@@ -0,0 +1,7 @@
+import {
+  to = auth0_organization_connection.my_org_conn
+  identity = {
+    organization_id = "org_XXXXX"
+    connection_id   = "con_XXXXX"
+  }
+}
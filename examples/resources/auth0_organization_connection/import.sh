@@ -4,3 +4,8 @@
 #
 # Example:
 terraform import auth0_organization_connection.my_org_conn "org_XXXXX::con_XXXXX"
+#
+# The organization and connection can also be specified by name, prefixed by "name:".
+#
+# Example:
+terraform import auth0_organization_connection.my_org_conn "name:acme::name:google-oauth2"
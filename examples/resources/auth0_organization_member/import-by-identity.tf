@@ -0,0 +1,7 @@
+import {
+  to = auth0_organization_member.my_org_member
+  identity = {
+    organization_id = "org_XXXXX"
+    user_id         = "auth0|XXXXX"
+  }
+}
@@ -4,3 +4,8 @@
 #
 # Example:
 terraform import auth0_organization_member.my_org_member "org_XXXXX::auth0|XXXXX"
+#
+# The organization can also be specified by name, prefixed by "name:".
+#
+# Example:
+terraform import auth0_organization_member.my_org_member "name:acme::auth0|XXXXX"
@@ -0,0 +1,8 @@
+import {
+  to = auth0_organization_member_role.my_org_member_role
+  identity = {
+    organization_id = "org_XXXXX"
+    user_id         = "auth0|XXXXX"
+    role_id         = "role_XXXX"
+  }
+}
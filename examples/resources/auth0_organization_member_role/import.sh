@@ -4,3 +4,8 @@
 #
 # Example:
 terraform import auth0_organization_member_role.my_org_member_role "org_XXXXX::auth0|XXXXX::role_XXXX"
+#
+# The organization and role can also be specified by name, prefixed by "name:".
+#
+# Example:
+terraform import auth0_organization_member_role.my_org_member_role "name:acme::auth0|XXXXX::name:Admin"
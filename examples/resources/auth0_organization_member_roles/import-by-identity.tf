@@ -0,0 +1,7 @@
+import {
+  to = auth0_organization_member_roles.my_org_member_roles
+  identity = {
+    organization_id = "org_XXXXX"
+    user_id         = "auth0|XXXXX"
+  }
+}
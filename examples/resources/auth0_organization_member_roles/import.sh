@@ -4,3 +4,8 @@
 #
 # Example:
 terraform import auth0_organization_member_roles.my_org_member_roles "org_XXXXX::auth0|XXXXX"
+#
+# The organization can also be specified by name, prefixed by "name:".
+#
+# Example:
+terraform import auth0_organization_member_roles.my_org_member_roles "name:acme::auth0|XXXXX"
@@ -0,0 +1,7 @@
+import {
+  to = auth0_prompt_custom_text.example
+  identity = {
+    prompt   = "login"
+    language = "en"
+  }
+}
@@ -2,3 +2,9 @@
 #
 # Example:
 terraform import auth0_resource_server.my_resource_server "XXXXXXXXXXXXXXXXXXXXXXX"
+#
+# It can also be imported by specifying the resource server identifier, prefixed by "identifier:".
+# The import fails when several resource servers share the same identifier.
+#
+# Example:
+terraform import auth0_resource_server.my_resource_server "identifier:https://api.example.com"
@@ -0,0 +1,7 @@
+import {
+  to = auth0_resource_server_scope.scope
+  identity = {
+    resource_server_identifier = "https://api.travel0.com/v1"
+    scope                      = "read:posts"
+  }
+}
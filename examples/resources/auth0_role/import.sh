@@ -2,3 +2,9 @@
 #
 # Example:
 terraform import auth0_role.my_role "XXXXXXXXXXXXXXXXXXXXXXX"
+#
+# It can also be imported by specifying the role name, prefixed by "name:".
+# The import fails when several roles share the same name.
+#
+# Example:
+terraform import auth0_role.my_role "name:Admin"
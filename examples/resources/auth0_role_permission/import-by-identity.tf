@@ -0,0 +1,8 @@
+import {
+  to = auth0_role_permission.permission
+  identity = {
+    role_id                    = "rol_XXXXXXXXXXXXX"
+    resource_server_identifier = "https://example.com"
+    permission                 = "read:foo"
+  }
+}
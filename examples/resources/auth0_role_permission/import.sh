@@ -4,3 +4,8 @@
 #
 # Example:
 terraform import auth0_role_permission.permission "rol_XXXXXXXXXXXXX::https://example.com::read:foo"
+#
+# The role can also be specified by name, prefixed by "name:".
+#
+# Example:
+terraform import auth0_role_permission.permission "name:Admin::https://example.com::read:foo"
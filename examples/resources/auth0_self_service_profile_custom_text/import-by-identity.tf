@@ -0,0 +1,8 @@
+import {
+  to = auth0_self_service_profile_custom_text.example
+  identity = {
+    sso_id   = "some-sso-id"
+    language = "en"
+    page     = "get-started"
+  }
+}
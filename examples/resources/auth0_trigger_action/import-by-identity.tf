@@ -0,0 +1,7 @@
+import {
+  to = auth0_trigger_action.post_login_action
+  identity = {
+    trigger   = "post-login"
+    action_id = "28b5c8fa-d371-5734-acf6-d0cf80ead918"
+  }
+}
@@ -4,3 +4,8 @@
 #
 # Example:
 terraform import auth0_trigger_action.post_login_action "post-login::28b5c8fa-d371-5734-acf6-d0cf80ead918"
+#
+# The action can also be specified by name, prefixed by "name:".
+#
+# Example:
+terraform import auth0_trigger_action.post_login_action "post-login::name:my-action"
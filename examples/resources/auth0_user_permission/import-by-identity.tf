@@ -0,0 +1,8 @@
+import {
+  to = auth0_user_permission.permission
+  identity = {
+    user_id                    = "auth0|111111111111111111111111"
+    resource_server_identifier = "https://api.travel0.com/v1"
+    permission                 = "read:posts"
+  }
+}
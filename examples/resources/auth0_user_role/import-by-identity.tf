@@ -0,0 +1,7 @@
+import {
+  to = auth0_user_role.user_role
+  identity = {
+    user_id = "auth0|111111111111111111111111"
+    role_id = "role_123"
+  }
+}
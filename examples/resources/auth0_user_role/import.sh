@@ -4,3 +4,8 @@
 #
 # Example:
 terraform import auth0_user_role.user_role "auth0|111111111111111111111111::role_123"
+#
+# The role can also be specified by name, prefixed by "name:".
+#
+# Example:
+terraform import auth0_user_role.user_role "auth0|111111111111111111111111::name:Admin"
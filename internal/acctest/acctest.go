@@ -12,15 +12,23 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
-	"gopkg.in/dnaeon/go-vcr.v3/recorder"
 
+	"github.com/auth0/terraform-provider-auth0/internal/acctest/fakeapi"
 	"github.com/auth0/terraform-provider-auth0/internal/config"
 	"github.com/auth0/terraform-provider-auth0/internal/provider"
 )
 
-// Test checks to see if http recordings are enabled and runs the tests
-// in parallel if they are, otherwise it simply wraps resource.Test.
+// Test checks to see if the fake Management API or http recordings are enabled
+// and runs the tests in parallel if they are, otherwise it simply wraps resource.Test.
 func Test(t *testing.T, testCase resource.TestCase) {
+	if fakeAPIIsEnabled() {
+		server := fakeapi.NewServer(t)
+		testCase.ProviderFactories = testFactoriesWithFakeAPI(server)
+		resource.ParallelTest(t, testCase)
+
+		return
+	}
+
 	if httpRecordingsAreEnabled() {
 		httpRecorder := newHTTPRecorder(t)
 		testCase.ProviderFactories = testFactoriesWithHTTPRecordings(httpRecorder)
@@ -38,6 +46,13 @@ func httpRecordingsAreEnabled() bool {
 	return httpRecordings == "true" || httpRecordings == "1" || httpRecordings == "on"
 }
 
+// fakeAPIIsEnabled checks whether the tests should run against an in-memory
+// fake of the Management API, which takes precedence over the http recordings.
+func fakeAPIIsEnabled() bool {
+	fakeAPI := os.Getenv("AUTH0_FAKE_API")
+	return fakeAPI == "true" || fakeAPI == "1" || fakeAPI == "on"
+}
+
 // TestFactories returns the configured auth0 provider used in testing.
 func TestFactories() map[string]func() (*schema.Provider, error) {
 	return map[string]func() (*schema.Provider, error){
@@ -47,7 +62,7 @@ func TestFactories() map[string]func() (*schema.Provider, error) {
 	}
 }
 
-func testFactoriesWithHTTPRecordings(httpRecorder *recorder.Recorder) map[string]func() (*schema.Provider, error) {
+func testFactoriesWithHTTPRecordings(httpRecorder *cassetteRecorder) map[string]func() (*schema.Provider, error) {
 	return map[string]func() (*schema.Provider, error){
 		"auth0": func() (*schema.Provider, error) {
 			auth0Provider := provider.New()
@@ -59,7 +74,48 @@ func testFactoriesWithHTTPRecordings(httpRecorder *recorder.Recorder) map[string
 	}
 }
 
-func configureTestProviderWithHTTPRecordings(httpRecorder *recorder.Recorder) schema.ConfigureContextFunc {
+func testFactoriesWithFakeAPI(server *fakeapi.Server) map[string]func() (*schema.Provider, error) {
+	return map[string]func() (*schema.Provider, error){
+		"auth0": func() (*schema.Provider, error) {
+			auth0Provider := provider.New()
+
+			auth0Provider.ConfigureContextFunc = configureTestProviderWithFakeAPI(server)
+
+			return auth0Provider, nil
+		},
+	}
+}
+
+func configureTestProviderWithFakeAPI(server *fakeapi.Server) schema.ConfigureContextFunc {
+	return func(_ context.Context, data *schema.ResourceData) (interface{}, diag.Diagnostics) {
+		debug := data.Get("debug").(bool)
+
+		apiClient, err := management.New(
+			RecordingsDomain,
+			management.WithStaticToken("insecure"),
+			management.WithClient(server.Client()),
+			management.WithDebug(debug),
+			management.WithNoRetries(),
+		)
+		if err != nil {
+			return nil, diag.FromErr(err)
+		}
+
+		apiClientV3, err := managementv3.New(
+			RecordingsDomain,
+			option.WithToken("insecure"),
+			option.WithHTTPClient(server.Client()),
+			option.WithDebug(debug),
+		)
+		if err != nil {
+			return nil, diag.FromErr(err)
+		}
+
+		return config.NewWithV3(apiClient, apiClientV3), nil
+	}
+}
+
+func configureTestProviderWithHTTPRecordings(httpRecorder *cassetteRecorder) schema.ConfigureContextFunc {
 	return func(_ context.Context, data *schema.ResourceData) (interface{}, diag.Diagnostics) {
 		domain := data.Get("domain").(string)
 		debug := data.Get("debug").(bool)
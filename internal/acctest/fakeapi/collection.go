@@ -0,0 +1,247 @@
+package fakeapi
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// object is a Management API object, as decoded from its JSON representation.
+type object = map[string]interface{}
+
+// collection stores the objects of a Management API endpoint, e.g. /api/v2/roles,
+// in the order they were created.
+type collection struct {
+	// name is used in error messages, e.g. "resource server".
+	name string
+	// idField is the property holding the ID of the objects, e.g. "client_id".
+	idField string
+	// newID generates the ID of a new object.
+	newID func() string
+	// listKey is the property holding the objects in a list response.
+	listKey string
+	// uniqueKey returns the key an object must not share with another one,
+	// as the Management API responds with a 409 on create or update if it does.
+	uniqueKey func(object) string
+	// defaults sets the properties the Management API generates on create.
+	defaults func(object)
+	// writeOnly are the properties only used in payloads, which the
+	// Management API never returns, e.g. the password of a user.
+	writeOnly []string
+	// matches filters the objects returned by a list request by its query.
+	matches func(url.Values, object) bool
+	// onDelete removes the relations of a deleted object, e.g. the roles of a user.
+	onDelete func(id string)
+
+	ids     []string
+	objects map[string]object
+}
+
+func (c *collection) get(id string) (object, bool) {
+	obj, ok := c.objects[id]
+	return obj, ok
+}
+
+func (c *collection) all() []object {
+	objects := make([]object, 0, len(c.ids))
+	for _, id := range c.ids {
+		objects = append(objects, c.objects[id])
+	}
+	return objects
+}
+
+func (c *collection) conflicts(obj object, exceptID string) bool {
+	if c.uniqueKey == nil {
+		return false
+	}
+
+	key := c.uniqueKey(obj)
+	if key == "" {
+		return false
+	}
+
+	for _, id := range c.ids {
+		if id != exceptID && c.uniqueKey(c.objects[id]) == key {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (c *collection) create(obj object) (object, *apiError) {
+	id := c.newID()
+	obj[c.idField] = id
+
+	if c.defaults != nil {
+		c.defaults(obj)
+	}
+	c.removeWriteOnly(obj)
+
+	if c.conflicts(obj, "") {
+		return nil, conflictError("The %s already exists.", c.name)
+	}
+
+	if c.objects == nil {
+		c.objects = map[string]object{}
+	}
+
+	c.ids = append(c.ids, id)
+	c.objects[id] = obj
+
+	return obj, nil
+}
+
+// update merges the changes into the object with the given ID. As with the
+// Management API, the top level properties of the changes replace the ones
+// of the object, and the properties set to null are removed from it.
+func (c *collection) update(id string, changes object) (object, *apiError) {
+	existing, ok := c.objects[id]
+	if !ok {
+		return nil, c.notFoundError()
+	}
+
+	updated := make(object, len(existing))
+	for key, value := range existing {
+		updated[key] = value
+	}
+
+	for key, value := range changes {
+		if key == c.idField {
+			continue
+		}
+
+		if value == nil {
+			delete(updated, key)
+			continue
+		}
+
+		updated[key] = value
+	}
+	c.removeWriteOnly(updated)
+
+	if c.conflicts(updated, id) {
+		return nil, conflictError("The %s already exists.", c.name)
+	}
+
+	c.objects[id] = updated
+
+	return updated, nil
+}
+
+func (c *collection) delete(id string) bool {
+	if _, ok := c.objects[id]; !ok {
+		return false
+	}
+
+	delete(c.objects, id)
+	for index, existingID := range c.ids {
+		if existingID == id {
+			c.ids = append(c.ids[:index], c.ids[index+1:]...)
+			break
+		}
+	}
+
+	if c.onDelete != nil {
+		c.onDelete(id)
+	}
+
+	return true
+}
+
+func (c *collection) removeWriteOnly(obj object) {
+	for _, key := range c.writeOnly {
+		delete(obj, key)
+	}
+}
+
+func (c *collection) notFoundError() *apiError {
+	return notFoundError("The %s does not exist.", c.name)
+}
+
+// serve handles the requests made to the collection itself, listing and
+// creating objects, and to one of its objects, reading, updating and
+// deleting it. The segments are the ones of the path after the collection.
+func (c *collection) serve(w http.ResponseWriter, r *http.Request, segments []string) {
+	if len(segments) == 0 {
+		switch r.Method {
+		case http.MethodGet:
+			var objects []interface{}
+			for _, obj := range c.all() {
+				if c.matches == nil || c.matches(r.URL.Query(), obj) {
+					objects = append(objects, obj)
+				}
+			}
+			writeList(w, r, c.listKey, objects)
+		case http.MethodPost:
+			var obj object
+			if err := decodeBody(r, &obj); err != nil {
+				writeError(w, err)
+				return
+			}
+
+			created, err := c.create(obj)
+			if err != nil {
+				writeError(w, err)
+				return
+			}
+			writeJSON(w, http.StatusCreated, created)
+		default:
+			writeError(w, methodNotAllowedError(r))
+		}
+		return
+	}
+
+	id := segments[0]
+
+	switch r.Method {
+	case http.MethodGet:
+		obj, ok := c.get(id)
+		if !ok {
+			writeError(w, c.notFoundError())
+			return
+		}
+		writeJSON(w, http.StatusOK, obj)
+	case http.MethodPatch:
+		var changes object
+		if err := decodeBody(r, &changes); err != nil {
+			writeError(w, err)
+			return
+		}
+
+		updated, err := c.update(id, changes)
+		if err != nil {
+			writeError(w, err)
+			return
+		}
+		writeJSON(w, http.StatusOK, updated)
+	case http.MethodDelete:
+		if !c.delete(id) {
+			writeError(w, c.notFoundError())
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		writeError(w, methodNotAllowedError(r))
+	}
+}
+
+// idGenerator returns a function generating sequential IDs
+// of the given length, formatted as hexadecimal numbers.
+func idGenerator(prefix string, length int) func() string {
+	var count int
+	return func() string {
+		count++
+		return fmt.Sprintf("%s%0*x", prefix, length, count)
+	}
+}
+
+func stringProperty(obj object, key string) string {
+	value, _ := obj[key].(string)
+	return value
+}
+
+func containsFold(s, substr string) bool {
+	return strings.Contains(strings.ToLower(s), strings.ToLower(substr))
+}
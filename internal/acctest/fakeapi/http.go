@@ -0,0 +1,141 @@
+package fakeapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+const defaultPageSize = 50
+
+// apiError is the body of an error response of the Management API.
+type apiError struct {
+	StatusCode int    `json:"statusCode"`
+	Error      string `json:"error"`
+	Message    string `json:"message"`
+	ErrorCode  string `json:"errorCode,omitempty"`
+}
+
+func newAPIError(statusCode int, format string, args ...interface{}) *apiError {
+	return &apiError{
+		StatusCode: statusCode,
+		Error:      http.StatusText(statusCode),
+		Message:    fmt.Sprintf(format, args...),
+	}
+}
+
+func badRequestError(format string, args ...interface{}) *apiError {
+	return newAPIError(http.StatusBadRequest, format, args...)
+}
+
+func notFoundError(format string, args ...interface{}) *apiError {
+	err := newAPIError(http.StatusNotFound, format, args...)
+	err.ErrorCode = "inexistent_resource"
+	return err
+}
+
+func conflictError(format string, args ...interface{}) *apiError {
+	return newAPIError(http.StatusConflict, format, args...)
+}
+
+func methodNotAllowedError(r *http.Request) *apiError {
+	return newAPIError(http.StatusMethodNotAllowed, "The %s method is not allowed on %s.", r.Method, r.URL.Path)
+}
+
+func writeJSON(w http.ResponseWriter, statusCode int, body interface{}) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(statusCode)
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+func writeError(w http.ResponseWriter, err *apiError) {
+	writeJSON(w, err.StatusCode, err)
+}
+
+func decodeBody(r *http.Request, body interface{}) *apiError {
+	if err := json.NewDecoder(r.Body).Decode(body); err != nil {
+		return badRequestError("Invalid request payload JSON format: %s.", err)
+	}
+	return nil
+}
+
+// writeList writes a list response the way the Management API does. Requests
+// with the from or take parameters are paginated with checkpoints, which are
+// the offsets of the next page, and any other request is paginated by page.
+func writeList(w http.ResponseWriter, r *http.Request, key string, items []interface{}) {
+	if items == nil {
+		items = []interface{}{}
+	}
+
+	query := r.URL.Query()
+
+	if query.Has("from") || query.Has("take") {
+		from, err := intParameter(query, "from", 0)
+		if err != nil {
+			writeError(w, err)
+			return
+		}
+
+		take, err := intParameter(query, "take", defaultPageSize)
+		if err != nil {
+			writeError(w, err)
+			return
+		}
+
+		start, end := pageBounds(len(items), from, take)
+		response := map[string]interface{}{key: items[start:end]}
+		if end < len(items) {
+			response["next"] = strconv.Itoa(end)
+		}
+
+		writeJSON(w, http.StatusOK, response)
+		return
+	}
+
+	page, err := intParameter(query, "page", 0)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	perPage, err := intParameter(query, "per_page", defaultPageSize)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	start, end := pageBounds(len(items), page*perPage, perPage)
+
+	if query.Get("include_totals") == "false" {
+		writeJSON(w, http.StatusOK, items[start:end])
+		return
+	}
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"start":  start,
+		"limit":  perPage,
+		"length": end - start,
+		"total":  len(items),
+		key:      items[start:end],
+	})
+}
+
+func pageBounds(length, offset, size int) (int, int) {
+	start := min(offset, length)
+	return start, min(start+size, length)
+}
+
+func intParameter(query url.Values, name string, defaultValue int) (int, *apiError) {
+	if !query.Has(name) {
+		return defaultValue, nil
+	}
+
+	value, err := strconv.Atoi(query.Get(name))
+	if err != nil || value < 0 {
+		return 0, badRequestError("Query validation error: invalid value %q for the %s parameter.", query.Get(name), name)
+	}
+
+	return value, nil
+}
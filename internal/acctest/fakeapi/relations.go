@@ -0,0 +1,700 @@
+package fakeapi
+
+import (
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// permission is a permission of a resource server
+// granted to a role or directly to a user.
+type permission struct {
+	ResourceServerIdentifier string `json:"resource_server_identifier"`
+	Name                     string `json:"permission_name"`
+}
+
+// memberKey identifies a user in an organization.
+type memberKey struct {
+	organizationID string
+	userID         string
+}
+
+// serveEnabledClients handles the requests to /api/v2/connections/{id}/clients.
+func (s *Server) serveEnabledClients(w http.ResponseWriter, r *http.Request, connectionID string) {
+	if _, ok := s.connections.get(connectionID); !ok {
+		writeError(w, s.connections.notFoundError())
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		var clients []interface{}
+		for _, clientID := range s.enabledClients[connectionID] {
+			clients = append(clients, object{"client_id": clientID})
+		}
+		writeList(w, r, "clients", clients)
+	case http.MethodPatch:
+		var changes []struct {
+			ClientID string `json:"client_id"`
+			Status   bool   `json:"status"`
+		}
+		if err := decodeBody(r, &changes); err != nil {
+			writeError(w, err)
+			return
+		}
+
+		for _, change := range changes {
+			if _, ok := s.clients.get(change.ClientID); !ok {
+				writeError(w, badRequestError("The client %q does not exist.", change.ClientID))
+				return
+			}
+		}
+
+		for _, change := range changes {
+			if change.Status {
+				s.enabledClients[connectionID] = appendUnique(s.enabledClients[connectionID], change.ClientID)
+			} else {
+				s.enabledClients[connectionID] = remove(s.enabledClients[connectionID], change.ClientID)
+			}
+		}
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		writeError(w, methodNotAllowedError(r))
+	}
+}
+
+// resourceServerID returns the ID of the resource server with the given ID or
+// identifier. Identifiers are often URLs, which the provider escapes before
+// putting them in the path, so they are unescaped until one matches.
+func (s *Server) resourceServerID(idOrIdentifier string) string {
+	if _, ok := s.resourceServers.get(idOrIdentifier); ok {
+		return idOrIdentifier
+	}
+
+	for identifier := idOrIdentifier; ; {
+		for _, resourceServer := range s.resourceServers.all() {
+			if stringProperty(resourceServer, "identifier") == identifier {
+				return stringProperty(resourceServer, "id")
+			}
+		}
+
+		unescaped, err := url.PathUnescape(identifier)
+		if err != nil || unescaped == identifier {
+			return idOrIdentifier
+		}
+		identifier = unescaped
+	}
+}
+
+// servePermissions handles the requests to the permissions of a
+// role, /api/v2/roles/{id}/permissions, or of a user, /api/v2/users/{id}/permissions.
+func (s *Server) servePermissions(
+	w http.ResponseWriter,
+	r *http.Request,
+	owners *collection,
+	permissions map[string][]permission,
+	ownerID string,
+) {
+	if _, ok := owners.get(ownerID); !ok {
+		writeError(w, owners.notFoundError())
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		var items []interface{}
+		for _, granted := range permissions[ownerID] {
+			items = append(items, s.permissionObject(granted))
+		}
+		writeList(w, r, "permissions", items)
+	case http.MethodPost, http.MethodDelete:
+		var body struct {
+			Permissions []permission `json:"permissions"`
+		}
+		if err := decodeBody(r, &body); err != nil {
+			writeError(w, err)
+			return
+		}
+
+		for _, changed := range body.Permissions {
+			if r.Method == http.MethodPost {
+				if err := s.checkPermissionExists(changed); err != nil {
+					writeError(w, err)
+					return
+				}
+			}
+		}
+
+		for _, changed := range body.Permissions {
+			if r.Method == http.MethodPost {
+				permissions[ownerID] = appendUnique(permissions[ownerID], changed)
+			} else {
+				permissions[ownerID] = remove(permissions[ownerID], changed)
+			}
+		}
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		writeError(w, methodNotAllowedError(r))
+	}
+}
+
+func (s *Server) checkPermissionExists(granted permission) *apiError {
+	resourceServer, ok := s.resourceServers.get(s.resourceServerID(granted.ResourceServerIdentifier))
+	if !ok {
+		return notFoundError("The resource server %q does not exist.", granted.ResourceServerIdentifier)
+	}
+
+	if _, ok := resourceServerScope(resourceServer, granted.Name); !ok {
+		return badRequestError(
+			"The permission %q does not exist on the resource server %q.",
+			granted.Name,
+			granted.ResourceServerIdentifier,
+		)
+	}
+
+	return nil
+}
+
+func (s *Server) permissionObject(granted permission) object {
+	obj := object{
+		"resource_server_identifier": granted.ResourceServerIdentifier,
+		"permission_name":            granted.Name,
+	}
+
+	resourceServer, ok := s.resourceServers.get(s.resourceServerID(granted.ResourceServerIdentifier))
+	if !ok {
+		return obj
+	}
+
+	obj["resource_server_name"] = stringProperty(resourceServer, "name")
+	if scope, ok := resourceServerScope(resourceServer, granted.Name); ok {
+		obj["description"] = stringProperty(scope, "description")
+	}
+
+	return obj
+}
+
+func resourceServerScope(resourceServer object, name string) (object, bool) {
+	scopes, _ := resourceServer["scopes"].([]interface{})
+	for _, item := range scopes {
+		if scope, ok := item.(object); ok && stringProperty(scope, "value") == name {
+			return scope, true
+		}
+	}
+	return nil, false
+}
+
+// serveRoleUsers handles the requests to /api/v2/roles/{id}/users.
+func (s *Server) serveRoleUsers(w http.ResponseWriter, r *http.Request, roleID string) {
+	if _, ok := s.roles.get(roleID); !ok {
+		writeError(w, s.roles.notFoundError())
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		var users []interface{}
+		for _, user := range s.users.all() {
+			if contains(s.userRoles[stringProperty(user, "user_id")], roleID) {
+				users = append(users, userSummary(user))
+			}
+		}
+		writeList(w, r, "users", users)
+	case http.MethodPost:
+		var body struct {
+			Users []string `json:"users"`
+		}
+		if err := decodeBody(r, &body); err != nil {
+			writeError(w, err)
+			return
+		}
+
+		if err := checkAllExist(s.users, body.Users); err != nil {
+			writeError(w, err)
+			return
+		}
+
+		for _, userID := range body.Users {
+			s.userRoles[userID] = appendUnique(s.userRoles[userID], roleID)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		writeError(w, methodNotAllowedError(r))
+	}
+}
+
+// serveUserRoles handles the requests to /api/v2/users/{id}/roles.
+func (s *Server) serveUserRoles(w http.ResponseWriter, r *http.Request, userID string) {
+	if _, ok := s.users.get(userID); !ok {
+		writeError(w, s.users.notFoundError())
+		return
+	}
+
+	serveRoleAssignments(w, r, s, s.userRoles, userID)
+}
+
+// serveOrganizationByName handles the requests to /api/v2/organizations/name/{name}.
+func (s *Server) serveOrganizationByName(w http.ResponseWriter, r *http.Request, name string) {
+	if r.Method != http.MethodGet {
+		writeError(w, methodNotAllowedError(r))
+		return
+	}
+
+	for _, organization := range s.organizations.all() {
+		if stringProperty(organization, "name") == name {
+			writeJSON(w, http.StatusOK, organization)
+			return
+		}
+	}
+
+	writeError(w, s.organizations.notFoundError())
+}
+
+// serveOrganizationMembers handles the requests to /api/v2/organizations/{id}/members.
+func (s *Server) serveOrganizationMembers(w http.ResponseWriter, r *http.Request, organizationID string) {
+	if _, ok := s.organizations.get(organizationID); !ok {
+		writeError(w, s.organizations.notFoundError())
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		includeRoles := contains(strings.Split(r.URL.Query().Get("fields"), ","), "roles")
+
+		var members []interface{}
+		for _, userID := range s.organizationMembers[organizationID] {
+			user, _ := s.users.get(userID)
+			member := userSummary(user)
+
+			if includeRoles {
+				member["roles"] = s.roleSummaries(s.memberRoles[memberKey{organizationID, userID}])
+			}
+
+			members = append(members, member)
+		}
+		writeList(w, r, "members", members)
+	case http.MethodPost, http.MethodDelete:
+		var body struct {
+			Members []string `json:"members"`
+		}
+		if err := decodeBody(r, &body); err != nil {
+			writeError(w, err)
+			return
+		}
+
+		if r.Method == http.MethodPost {
+			if err := checkAllExist(s.users, body.Members); err != nil {
+				writeError(w, err)
+				return
+			}
+		}
+
+		for _, userID := range body.Members {
+			if r.Method == http.MethodPost {
+				s.organizationMembers[organizationID] = appendUnique(s.organizationMembers[organizationID], userID)
+			} else {
+				s.organizationMembers[organizationID] = remove(s.organizationMembers[organizationID], userID)
+				delete(s.memberRoles, memberKey{organizationID, userID})
+			}
+		}
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		writeError(w, methodNotAllowedError(r))
+	}
+}
+
+// serveOrganizationMemberRoles handles the requests
+// to /api/v2/organizations/{id}/members/{user_id}/roles.
+func (s *Server) serveOrganizationMemberRoles(w http.ResponseWriter, r *http.Request, member memberKey) {
+	if _, ok := s.organizations.get(member.organizationID); !ok {
+		writeError(w, s.organizations.notFoundError())
+		return
+	}
+
+	if r.Method != http.MethodGet && !contains(s.organizationMembers[member.organizationID], member.userID) {
+		writeError(w, badRequestError("The user %q is not a member of the organization.", member.userID))
+		return
+	}
+
+	serveRoleAssignments(w, r, s, s.memberRoles, member)
+}
+
+// serveOrganizationConnections handles the requests to /api/v2/organizations/{id}/connections,
+// enabling connections for the organization, and to one of its enabled connections. The listKey
+// is the last segment of the path, as the former enabled_connections endpoints are served too.
+func (s *Server) serveOrganizationConnections(
+	w http.ResponseWriter,
+	r *http.Request,
+	organizationID string,
+	listKey string,
+	segments []string,
+) {
+	if _, ok := s.organizations.get(organizationID); !ok {
+		writeError(w, s.organizations.notFoundError())
+		return
+	}
+
+	if len(segments) == 0 {
+		switch r.Method {
+		case http.MethodGet:
+			var connections []interface{}
+			for _, enabled := range s.organizationConnections[organizationID] {
+				connections = append(connections, s.organizationConnection(enabled))
+			}
+			writeList(w, r, listKey, connections)
+		case http.MethodPost:
+			var enabled object
+			if err := decodeBody(r, &enabled); err != nil {
+				writeError(w, err)
+				return
+			}
+
+			connectionID := stringProperty(enabled, "connection_id")
+			if _, ok := s.connections.get(connectionID); !ok {
+				writeError(w, s.connections.notFoundError())
+				return
+			}
+
+			if s.organizationConnectionIndex(organizationID, connectionID) >= 0 {
+				writeError(w, conflictError("The connection is already enabled for the organization."))
+				return
+			}
+
+			defaults := object{
+				"assign_membership_on_login": false,
+				"is_signup_enabled":          false,
+				"show_as_button":             true,
+			}
+			for key, value := range defaults {
+				if _, ok := enabled[key]; !ok {
+					enabled[key] = value
+				}
+			}
+
+			s.organizationConnections[organizationID] = append(s.organizationConnections[organizationID], enabled)
+			writeJSON(w, http.StatusCreated, s.organizationConnection(enabled))
+		default:
+			writeError(w, methodNotAllowedError(r))
+		}
+		return
+	}
+
+	index := s.organizationConnectionIndex(organizationID, segments[0])
+	if index < 0 {
+		writeError(w, notFoundError("The connection is not enabled for the organization."))
+		return
+	}
+
+	enabled := s.organizationConnections[organizationID][index]
+
+	switch r.Method {
+	case http.MethodGet:
+		writeJSON(w, http.StatusOK, s.organizationConnection(enabled))
+	case http.MethodPatch:
+		var changes object
+		if err := decodeBody(r, &changes); err != nil {
+			writeError(w, err)
+			return
+		}
+
+		for key, value := range changes {
+			if key != "connection_id" && key != "connection" {
+				enabled[key] = value
+			}
+		}
+		writeJSON(w, http.StatusOK, s.organizationConnection(enabled))
+	case http.MethodDelete:
+		connections := s.organizationConnections[organizationID]
+		s.organizationConnections[organizationID] = append(connections[:index:index], connections[index+1:]...)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		writeError(w, methodNotAllowedError(r))
+	}
+}
+
+func (s *Server) organizationConnectionIndex(organizationID, connectionID string) int {
+	for index, enabled := range s.organizationConnections[organizationID] {
+		if stringProperty(enabled, "connection_id") == connectionID {
+			return index
+		}
+	}
+	return -1
+}
+
+// organizationConnection returns an enabled connection of an organization
+// along with the name and strategy of the connection, as the API does.
+func (s *Server) organizationConnection(enabled object) object {
+	result := make(object, len(enabled)+1)
+	for key, value := range enabled {
+		result[key] = value
+	}
+
+	connection, _ := s.connections.get(stringProperty(enabled, "connection_id"))
+	result["connection"] = object{
+		"name":     stringProperty(connection, "name"),
+		"strategy": stringProperty(connection, "strategy"),
+	}
+
+	return result
+}
+
+// serveOrganizationClientGrants handles the requests to /api/v2/organizations/{id}/client-grants,
+// associating client grants with the organization, and to one of its client grants.
+func (s *Server) serveOrganizationClientGrants(
+	w http.ResponseWriter,
+	r *http.Request,
+	organizationID string,
+	segments []string,
+) {
+	if _, ok := s.organizations.get(organizationID); !ok {
+		writeError(w, s.organizations.notFoundError())
+		return
+	}
+
+	if len(segments) == 0 {
+		switch r.Method {
+		case http.MethodGet:
+			var clientGrants []interface{}
+			for _, grantID := range s.organizationClientGrants[organizationID] {
+				clientGrant, _ := s.clientGrants.get(grantID)
+				if s.clientGrants.matches(r.URL.Query(), clientGrant) {
+					clientGrants = append(clientGrants, clientGrant)
+				}
+			}
+			writeList(w, r, "client_grants", clientGrants)
+		case http.MethodPost:
+			var body struct {
+				GrantID string `json:"grant_id"`
+			}
+			if err := decodeBody(r, &body); err != nil {
+				writeError(w, err)
+				return
+			}
+
+			clientGrant, ok := s.clientGrants.get(body.GrantID)
+			if !ok {
+				writeError(w, s.clientGrants.notFoundError())
+				return
+			}
+
+			s.organizationClientGrants[organizationID] = appendUnique(s.organizationClientGrants[organizationID], body.GrantID)
+
+			associated := make(object, len(clientGrant))
+			for key, value := range clientGrant {
+				associated[key] = value
+			}
+			delete(associated, "id")
+			associated["grant_id"] = body.GrantID
+
+			writeJSON(w, http.StatusCreated, associated)
+		default:
+			writeError(w, methodNotAllowedError(r))
+		}
+		return
+	}
+
+	if r.Method != http.MethodDelete {
+		writeError(w, methodNotAllowedError(r))
+		return
+	}
+
+	grantID := segments[0]
+	if !contains(s.organizationClientGrants[organizationID], grantID) {
+		writeError(w, notFoundError("The client grant is not associated with the organization."))
+		return
+	}
+
+	s.organizationClientGrants[organizationID] = remove(s.organizationClientGrants[organizationID], grantID)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// serveRoleAssignments handles the requests listing, assigning and removing
+// the roles of a user, whether directly or as a member of an organization.
+func serveRoleAssignments[K comparable](
+	w http.ResponseWriter,
+	r *http.Request,
+	s *Server,
+	assignments map[K][]string,
+	key K,
+) {
+	switch r.Method {
+	case http.MethodGet:
+		writeList(w, r, "roles", s.roleSummaries(assignments[key]))
+	case http.MethodPost, http.MethodDelete:
+		var body struct {
+			Roles []string `json:"roles"`
+		}
+		if err := decodeBody(r, &body); err != nil {
+			writeError(w, err)
+			return
+		}
+
+		if r.Method == http.MethodPost {
+			if err := checkAllExist(s.roles, body.Roles); err != nil {
+				writeError(w, err)
+				return
+			}
+		}
+
+		for _, roleID := range body.Roles {
+			if r.Method == http.MethodPost {
+				assignments[key] = appendUnique(assignments[key], roleID)
+			} else {
+				assignments[key] = remove(assignments[key], roleID)
+			}
+		}
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		writeError(w, methodNotAllowedError(r))
+	}
+}
+
+func (s *Server) roleSummaries(roleIDs []string) []interface{} {
+	roles := make([]interface{}, 0, len(roleIDs))
+	for _, roleID := range roleIDs {
+		role, ok := s.roles.get(roleID)
+		if !ok {
+			continue
+		}
+
+		summary := object{"id": roleID, "name": role["name"]}
+		if description, ok := role["description"]; ok {
+			summary["description"] = description
+		}
+		roles = append(roles, summary)
+	}
+	return roles
+}
+
+func userSummary(user object) object {
+	summary := object{"user_id": user["user_id"]}
+	for _, key := range []string{"email", "name", "picture"} {
+		if value, ok := user[key]; ok {
+			summary[key] = value
+		}
+	}
+	return summary
+}
+
+func userConnection(user object) string {
+	identities, _ := user["identities"].([]interface{})
+	if len(identities) == 0 {
+		return ""
+	}
+
+	identity, _ := identities[0].(object)
+	return stringProperty(identity, "connection")
+}
+
+func checkAllExist(objects *collection, ids []string) *apiError {
+	for _, id := range ids {
+		if _, ok := objects.get(id); !ok {
+			return badRequestError("The %s %q does not exist.", objects.name, id)
+		}
+	}
+	return nil
+}
+
+func (s *Server) deleteClientRelations(clientID string) {
+	for connectionID, clientIDs := range s.enabledClients {
+		s.enabledClients[connectionID] = remove(clientIDs, clientID)
+	}
+
+	for _, clientGrant := range s.clientGrants.all() {
+		if stringProperty(clientGrant, "client_id") == clientID {
+			s.clientGrants.delete(stringProperty(clientGrant, "id"))
+		}
+	}
+}
+
+func (s *Server) deleteClientGrantRelations(clientGrantID string) {
+	for organizationID, grantIDs := range s.organizationClientGrants {
+		s.organizationClientGrants[organizationID] = remove(grantIDs, clientGrantID)
+	}
+}
+
+func (s *Server) deleteConnectionRelations(connectionID string) {
+	delete(s.enabledClients, connectionID)
+
+	for organizationID := range s.organizationConnections {
+		if index := s.organizationConnectionIndex(organizationID, connectionID); index >= 0 {
+			connections := s.organizationConnections[organizationID]
+			s.organizationConnections[organizationID] = append(connections[:index:index], connections[index+1:]...)
+		}
+	}
+}
+
+func (s *Server) deleteResourceServerRelations(string) {
+	identifiers := map[string]bool{}
+	for _, resourceServer := range s.resourceServers.all() {
+		identifiers[stringProperty(resourceServer, "identifier")] = true
+	}
+
+	for _, permissions := range []map[string][]permission{s.rolePermissions, s.userPermissions} {
+		for ownerID, granted := range permissions {
+			var kept []permission
+			for _, existing := range granted {
+				if identifiers[existing.ResourceServerIdentifier] {
+					kept = append(kept, existing)
+				}
+			}
+			permissions[ownerID] = kept
+		}
+	}
+}
+
+func (s *Server) deleteRoleRelations(roleID string) {
+	delete(s.rolePermissions, roleID)
+
+	for userID, roleIDs := range s.userRoles {
+		s.userRoles[userID] = remove(roleIDs, roleID)
+	}
+	for member, roleIDs := range s.memberRoles {
+		s.memberRoles[member] = remove(roleIDs, roleID)
+	}
+}
+
+func (s *Server) deleteOrganizationRelations(organizationID string) {
+	delete(s.organizationMembers, organizationID)
+	delete(s.organizationConnections, organizationID)
+	delete(s.organizationClientGrants, organizationID)
+
+	for member := range s.memberRoles {
+		if member.organizationID == organizationID {
+			delete(s.memberRoles, member)
+		}
+	}
+}
+
+func (s *Server) deleteUserRelations(userID string) {
+	delete(s.userRoles, userID)
+	delete(s.userPermissions, userID)
+
+	for organizationID, userIDs := range s.organizationMembers {
+		s.organizationMembers[organizationID] = remove(userIDs, userID)
+	}
+	for member := range s.memberRoles {
+		if member.userID == userID {
+			delete(s.memberRoles, member)
+		}
+	}
+}
+
+func appendUnique[T comparable](values []T, value T) []T {
+	for _, existing := range values {
+		if existing == value {
+			return values
+		}
+	}
+	return append(values, value)
+}
+
+func remove[T comparable](values []T, value T) []T {
+	kept := make([]T, 0, len(values))
+	for _, existing := range values {
+		if existing != value {
+			kept = append(kept, existing)
+		}
+	}
+	return kept
+}
@@ -0,0 +1,382 @@
+// Package fakeapi provides a stateful in-memory fake of the Auth0 Management API
+// endpoints used by the provider, so that the whole lifecycle of a resource can
+// be tested without a network connection or a tenant.
+//
+// It currently covers clients and their grants, connections and their enabled
+// clients, resource servers, roles and their permissions, organizations and
+// their members, connections and client grants, and users and their roles and
+// permissions. Requests to any other endpoint are answered with a 501 Not
+// Implemented error.
+package fakeapi
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+const apiPathPrefix = "/api/v2/"
+
+// Server is a fake Management API serving over httptest.
+type Server struct {
+	server *httptest.Server
+
+	mu sync.Mutex
+
+	clients         *collection
+	clientGrants    *collection
+	connections     *collection
+	resourceServers *collection
+	roles           *collection
+	organizations   *collection
+	users           *collection
+
+	enabledClients           map[string][]string     // Client IDs by connection ID.
+	rolePermissions          map[string][]permission // Permissions by role ID.
+	userPermissions          map[string][]permission // Permissions by user ID.
+	userRoles                map[string][]string     // Role IDs by user ID.
+	organizationMembers      map[string][]string     // User IDs by organization ID.
+	memberRoles              map[memberKey][]string  // Role IDs by organization member.
+	organizationConnections  map[string][]object     // Enabled connections by organization ID.
+	organizationClientGrants map[string][]string     // Client grant IDs by organization ID.
+}
+
+// NewServer starts a fake Management API with no
+// objects, which is closed when the test finishes.
+func NewServer(t testing.TB) *Server {
+	t.Helper()
+
+	s := &Server{
+		enabledClients:           map[string][]string{},
+		rolePermissions:          map[string][]permission{},
+		userPermissions:          map[string][]permission{},
+		userRoles:                map[string][]string{},
+		organizationMembers:      map[string][]string{},
+		memberRoles:              map[memberKey][]string{},
+		organizationConnections:  map[string][]object{},
+		organizationClientGrants: map[string][]string{},
+	}
+
+	s.clients = &collection{
+		name:    "client",
+		idField: "client_id",
+		newID:   idGenerator("", 32),
+		listKey: "clients",
+		defaults: func(obj object) {
+			obj["client_secret"] = strings.Repeat("0", 32) + stringProperty(obj, "client_id")
+			obj["signing_keys"] = []interface{}{
+				object{"cert": "-----BEGIN CERTIFICATE-----\r\nfake\r\n-----END CERTIFICATE-----"},
+			}
+		},
+		onDelete: s.deleteClientRelations,
+	}
+
+	s.clientGrants = &collection{
+		name:    "client grant",
+		idField: "id",
+		newID:   idGenerator("cgr_", 16),
+		listKey: "client_grants",
+		uniqueKey: func(obj object) string {
+			return stringProperty(obj, "client_id") + "|" + stringProperty(obj, "audience")
+		},
+		defaults: func(obj object) {
+			if _, ok := obj["scope"]; !ok {
+				obj["scope"] = []interface{}{}
+			}
+			if _, ok := obj["subject_type"]; !ok {
+				obj["subject_type"] = "client"
+			}
+		},
+		matches: func(query url.Values, obj object) bool {
+			if query.Has("audience") && query.Get("audience") != stringProperty(obj, "audience") {
+				return false
+			}
+			if query.Has("client_id") && query.Get("client_id") != stringProperty(obj, "client_id") {
+				return false
+			}
+			return true
+		},
+		onDelete: s.deleteClientGrantRelations,
+	}
+
+	s.connections = &collection{
+		name:    "connection",
+		idField: "id",
+		newID:   idGenerator("con_", 16),
+		listKey: "connections",
+		uniqueKey: func(obj object) string {
+			return stringProperty(obj, "name")
+		},
+		defaults: func(obj object) {
+			if _, ok := obj["options"]; !ok {
+				obj["options"] = object{}
+			}
+			if _, ok := obj["realms"]; !ok {
+				obj["realms"] = []interface{}{obj["name"]}
+			}
+			if _, ok := obj["is_domain_connection"]; !ok {
+				obj["is_domain_connection"] = false
+			}
+		},
+		matches: func(query url.Values, obj object) bool {
+			if query.Has("name") && query.Get("name") != stringProperty(obj, "name") {
+				return false
+			}
+			if strategies := query["strategy"]; len(strategies) > 0 {
+				return contains(strategies, stringProperty(obj, "strategy"))
+			}
+			return true
+		},
+		onDelete: s.deleteConnectionRelations,
+	}
+
+	s.resourceServers = &collection{
+		name:    "resource server",
+		idField: "id",
+		newID:   idGenerator("", 24),
+		listKey: "resource_servers",
+		uniqueKey: func(obj object) string {
+			return stringProperty(obj, "identifier")
+		},
+		defaults: func(obj object) {
+			defaults := object{
+				"signing_alg":            "RS256",
+				"token_lifetime":         86400,
+				"token_lifetime_for_web": 7200,
+				"allow_offline_access":   false,
+				"skip_consent_for_verifiable_first_party_clients": false,
+				"scopes": []interface{}{},
+				"subject_type_authorization": object{
+					"user":   object{"policy": "allow_all"},
+					"client": object{"policy": "require_client_grant"},
+				},
+			}
+			for key, value := range defaults {
+				if _, ok := obj[key]; !ok {
+					obj[key] = value
+				}
+			}
+		},
+		onDelete: s.deleteResourceServerRelations,
+	}
+
+	s.roles = &collection{
+		name:    "role",
+		idField: "id",
+		newID:   idGenerator("rol_", 16),
+		listKey: "roles",
+		uniqueKey: func(obj object) string {
+			return stringProperty(obj, "name")
+		},
+		defaults: func(obj object) {
+			if _, ok := obj["type"]; !ok {
+				obj["type"] = "tenant"
+			}
+		},
+		matches: func(query url.Values, obj object) bool {
+			return containsFold(stringProperty(obj, "name"), query.Get("name_filter"))
+		},
+		onDelete: s.deleteRoleRelations,
+	}
+
+	s.organizations = &collection{
+		name:    "organization",
+		idField: "id",
+		newID:   idGenerator("org_", 16),
+		listKey: "organizations",
+		uniqueKey: func(obj object) string {
+			return stringProperty(obj, "name")
+		},
+		onDelete: s.deleteOrganizationRelations,
+	}
+
+	s.users = &collection{
+		name:    "user",
+		idField: "user_id",
+		newID:   idGenerator("auth0|", 24),
+		listKey: "users",
+		uniqueKey: func(obj object) string {
+			if stringProperty(obj, "email") == "" {
+				return ""
+			}
+			return userConnection(obj) + "|" + strings.ToLower(stringProperty(obj, "email"))
+		},
+		defaults: func(obj object) {
+			now := time.Now().UTC().Format(time.RFC3339Nano)
+			obj["created_at"] = now
+			obj["updated_at"] = now
+
+			obj["identities"] = []interface{}{
+				object{
+					"connection": stringProperty(obj, "connection"),
+					"user_id":    strings.TrimPrefix(stringProperty(obj, "user_id"), "auth0|"),
+					"provider":   "auth0",
+					"isSocial":   false,
+				},
+			}
+
+			if _, ok := obj["email_verified"]; !ok && stringProperty(obj, "email") != "" {
+				obj["email_verified"] = false
+			}
+		},
+		writeOnly: []string{"connection", "password", "verify_email", "verify_password"},
+		onDelete:  s.deleteUserRelations,
+	}
+
+	s.server = httptest.NewServer(s)
+	t.Cleanup(s.server.Close)
+
+	return s
+}
+
+// URL returns the base URL of the server, e.g. http://127.0.0.1:1234.
+func (s *Server) URL() string {
+	return s.server.URL
+}
+
+// Client returns an HTTP client sending all its requests to the server, whatever
+// the scheme and host of their URL, so that the Management API clients can be
+// configured with any tenant domain and keep sending their requests over https.
+func (s *Server) Client() *http.Client {
+	target, _ := url.Parse(s.server.URL)
+
+	return &http.Client{
+		Transport: &redirectTransport{
+			target: target,
+			next:   s.server.Client().Transport,
+		},
+	}
+}
+
+type redirectTransport struct {
+	target *url.URL
+	next   http.RoundTripper
+}
+
+func (t *redirectTransport) RoundTrip(request *http.Request) (*http.Response, error) {
+	request = request.Clone(request.Context())
+	request.URL.Scheme = t.target.Scheme
+	request.URL.Host = t.target.Host
+	request.Host = t.target.Host
+
+	return t.next.RoundTrip(request)
+}
+
+// ServeHTTP routes the requests to the Management API endpoints.
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	segments, ok := pathSegments(r.URL)
+	if !ok {
+		writeError(w, notImplementedError(r))
+		return
+	}
+
+	switch {
+	case match(segments, "clients"), match(segments, "clients", "*"):
+		s.clients.serve(w, r, segments[1:])
+
+	case match(segments, "client-grants"), match(segments, "client-grants", "*"):
+		s.clientGrants.serve(w, r, segments[1:])
+
+	case match(segments, "connections"), match(segments, "connections", "*"):
+		s.connections.serve(w, r, segments[1:])
+	case match(segments, "connections", "*", "clients"):
+		s.serveEnabledClients(w, r, segments[1])
+
+	case match(segments, "resource-servers"):
+		s.resourceServers.serve(w, r, nil)
+	case match(segments, "resource-servers", "*"):
+		s.resourceServers.serve(w, r, []string{s.resourceServerID(segments[1])})
+
+	case match(segments, "roles"), match(segments, "roles", "*"):
+		s.roles.serve(w, r, segments[1:])
+	case match(segments, "roles", "*", "permissions"):
+		s.servePermissions(w, r, s.roles, s.rolePermissions, segments[1])
+	case match(segments, "roles", "*", "users"):
+		s.serveRoleUsers(w, r, segments[1])
+
+	case match(segments, "organizations"), match(segments, "organizations", "*"):
+		s.organizations.serve(w, r, segments[1:])
+	case match(segments, "organizations", "name", "*"):
+		s.serveOrganizationByName(w, r, segments[2])
+	case match(segments, "organizations", "*", "members"):
+		s.serveOrganizationMembers(w, r, segments[1])
+	case match(segments, "organizations", "*", "members", "*", "roles"):
+		s.serveOrganizationMemberRoles(w, r, memberKey{organizationID: segments[1], userID: segments[3]})
+	case match(segments, "organizations", "*", "connections"), match(segments, "organizations", "*", "connections", "*"),
+		match(segments, "organizations", "*", "enabled_connections"), match(segments, "organizations", "*", "enabled_connections", "*"):
+		s.serveOrganizationConnections(w, r, segments[1], segments[2], segments[3:])
+	case match(segments, "organizations", "*", "client-grants"), match(segments, "organizations", "*", "client-grants", "*"):
+		s.serveOrganizationClientGrants(w, r, segments[1], segments[3:])
+
+	case match(segments, "users"), match(segments, "users", "*"):
+		s.users.serve(w, r, segments[1:])
+	case match(segments, "users", "*", "roles"):
+		s.serveUserRoles(w, r, segments[1])
+	case match(segments, "users", "*", "permissions"):
+		s.servePermissions(w, r, s.users, s.userPermissions, segments[1])
+
+	default:
+		writeError(w, notImplementedError(r))
+	}
+}
+
+func notImplementedError(r *http.Request) *apiError {
+	return newAPIError(
+		http.StatusNotImplemented,
+		"The fake Management API doesn't implement %s %s.",
+		r.Method,
+		r.URL.Path,
+	)
+}
+
+// pathSegments returns the unescaped segments of the path after /api/v2/,
+// which keeps IDs and identifiers holding escaped slashes in one segment.
+func pathSegments(requestURL *url.URL) ([]string, bool) {
+	path, ok := strings.CutPrefix(requestURL.EscapedPath(), apiPathPrefix)
+	if !ok || path == "" {
+		return nil, false
+	}
+
+	segments := strings.Split(strings.TrimSuffix(path, "/"), "/")
+	for index, segment := range segments {
+		unescaped, err := url.PathUnescape(segment)
+		if err != nil {
+			return nil, false
+		}
+		segments[index] = unescaped
+	}
+
+	return segments, true
+}
+
+// match reports whether the segments match the pattern,
+// where a "*" matches any segment.
+func match(segments []string, pattern ...string) bool {
+	if len(segments) != len(pattern) {
+		return false
+	}
+
+	for index, segment := range pattern {
+		if segment != "*" && segment != segments[index] {
+			return false
+		}
+	}
+
+	return true
+}
+
+func contains(values []string, value string) bool {
+	for _, existing := range values {
+		if existing == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,408 @@
+package fakeapi
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/auth0/go-auth0"
+	"github.com/auth0/go-auth0/management"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestAPI(t *testing.T) *management.Management {
+	t.Helper()
+
+	server := NewServer(t)
+
+	api, err := management.New(
+		"fake-tenant.eu.auth0.com",
+		management.WithStaticToken("insecure"),
+		management.WithClient(server.Client()),
+		management.WithNoRetries(),
+	)
+	require.NoError(t, err)
+
+	return api
+}
+
+func assertStatus(t *testing.T, err error, expectedStatus int) {
+	t.Helper()
+
+	var managementErr management.Error
+	require.True(t, errors.As(err, &managementErr), "expected a Management API error, got %v", err)
+	assert.Equal(t, expectedStatus, managementErr.Status())
+}
+
+func TestServer_Clients(t *testing.T) {
+	ctx := context.Background()
+	api := newTestAPI(t)
+
+	client := &management.Client{
+		Name:        auth0.String("Acceptance Test"),
+		Description: auth0.String("A client"),
+		Callbacks:   &[]string{"https://example.com/callback"},
+	}
+	require.NoError(t, api.Client.Create(ctx, client))
+	require.NotEmpty(t, client.GetClientID())
+	assert.NotEmpty(t, client.GetClientSecret())
+
+	err := api.Request(ctx, http.MethodPatch, api.URI("clients", client.GetClientID()), map[string]interface{}{
+		"description": nil,
+	})
+	require.NoError(t, err)
+
+	require.NoError(t, api.Client.Update(ctx, client.GetClientID(), &management.Client{
+		Name: auth0.String("Acceptance Test - Updated"),
+	}))
+
+	readClient, err := api.Client.Read(ctx, client.GetClientID())
+	require.NoError(t, err)
+	assert.Equal(t, "Acceptance Test - Updated", readClient.GetName())
+	assert.Nil(t, readClient.Description)
+	assert.Equal(t, []string{"https://example.com/callback"}, readClient.GetCallbacks())
+
+	clientList, err := api.Client.List(ctx)
+	require.NoError(t, err)
+	require.Len(t, clientList.Clients, 1)
+	assert.Equal(t, 1, clientList.Total)
+
+	require.NoError(t, api.Client.Delete(ctx, client.GetClientID()))
+
+	_, err = api.Client.Read(ctx, client.GetClientID())
+	assertStatus(t, err, http.StatusNotFound)
+}
+
+func TestServer_Connections(t *testing.T) {
+	ctx := context.Background()
+	api := newTestAPI(t)
+
+	connection := &management.Connection{
+		Name:     auth0.String("Acceptance-Test-Connection"),
+		Strategy: auth0.String("auth0"),
+	}
+	require.NoError(t, api.Connection.Create(ctx, connection))
+	assert.Equal(t, []string{"Acceptance-Test-Connection"}, connection.GetRealms())
+
+	err := api.Connection.Create(ctx, &management.Connection{
+		Name:     auth0.String("Acceptance-Test-Connection"),
+		Strategy: auth0.String("auth0"),
+	})
+	assertStatus(t, err, http.StatusConflict)
+
+	var clientIDs []string
+	for _, name := range []string{"First", "Second", "Third"} {
+		client := &management.Client{Name: auth0.String(name)}
+		require.NoError(t, api.Client.Create(ctx, client))
+		clientIDs = append(clientIDs, client.GetClientID())
+	}
+
+	require.NoError(t, api.Connection.UpdateEnabledClients(ctx, connection.GetID(), []management.ConnectionEnabledClient{
+		{ClientID: auth0.String(clientIDs[0]), Status: auth0.Bool(true)},
+		{ClientID: auth0.String(clientIDs[1]), Status: auth0.Bool(true)},
+		{ClientID: auth0.String(clientIDs[2]), Status: auth0.Bool(true)},
+	}))
+	require.NoError(t, api.Connection.UpdateEnabledClients(ctx, connection.GetID(), []management.ConnectionEnabledClient{
+		{ClientID: auth0.String(clientIDs[1]), Status: auth0.Bool(false)},
+	}))
+
+	firstPage, err := api.Connection.ReadEnabledClients(ctx, connection.GetID(), management.Take(1))
+	require.NoError(t, err)
+	require.Len(t, firstPage.GetClients(), 1)
+	assert.Equal(t, clientIDs[0], firstPage.GetClients()[0].GetClientID())
+	require.True(t, firstPage.HasNext())
+
+	secondPage, err := api.Connection.ReadEnabledClients(ctx, connection.GetID(), management.From(firstPage.Next))
+	require.NoError(t, err)
+	require.Len(t, secondPage.GetClients(), 1)
+	assert.Equal(t, clientIDs[2], secondPage.GetClients()[0].GetClientID())
+	assert.False(t, secondPage.HasNext())
+
+	require.NoError(t, api.Client.Delete(ctx, clientIDs[0]))
+
+	enabledClients, err := api.Connection.ReadEnabledClients(ctx, connection.GetID())
+	require.NoError(t, err)
+	require.Len(t, enabledClients.GetClients(), 1)
+	assert.Equal(t, clientIDs[2], enabledClients.GetClients()[0].GetClientID())
+
+	connectionList, err := api.Connection.List(ctx, management.Parameter("strategy", "auth0"))
+	require.NoError(t, err)
+	require.Len(t, connectionList.Connections, 1)
+
+	connectionList, err = api.Connection.List(ctx, management.Parameter("strategy", "google-oauth2"))
+	require.NoError(t, err)
+	assert.Empty(t, connectionList.Connections)
+}
+
+func TestServer_ResourceServers(t *testing.T) {
+	ctx := context.Background()
+	api := newTestAPI(t)
+
+	resourceServer := &management.ResourceServer{
+		Name:       auth0.String("Acceptance Test"),
+		Identifier: auth0.String("https://uat.api.terraform-provider-auth0.com/v1"),
+	}
+	require.NoError(t, api.ResourceServer.Create(ctx, resourceServer))
+	assert.Equal(t, "RS256", resourceServer.GetSigningAlgorithm())
+	assert.Equal(t, 86400, resourceServer.GetTokenLifetime())
+	assert.Equal(t, "allow_all", resourceServer.GetSubjectTypeAuthorization().GetUser().GetPolicy())
+
+	readResourceServer, err := api.ResourceServer.Read(ctx, resourceServer.GetIdentifier())
+	require.NoError(t, err)
+	assert.Equal(t, resourceServer.GetID(), readResourceServer.GetID())
+
+	err = api.ResourceServer.Create(ctx, &management.ResourceServer{
+		Name:       auth0.String("Acceptance Test - Duplicate"),
+		Identifier: auth0.String("https://uat.api.terraform-provider-auth0.com/v1"),
+	})
+	assertStatus(t, err, http.StatusConflict)
+
+	require.NoError(t, api.ResourceServer.Delete(ctx, resourceServer.GetID()))
+
+	_, err = api.ResourceServer.Read(ctx, resourceServer.GetIdentifier())
+	assertStatus(t, err, http.StatusNotFound)
+}
+
+func TestServer_RolesAndUsers(t *testing.T) {
+	ctx := context.Background()
+	api := newTestAPI(t)
+
+	resourceServer := &management.ResourceServer{
+		Name:       auth0.String("Acceptance Test"),
+		Identifier: auth0.String("https://uat.api.terraform-provider-auth0.com"),
+		Scopes: &[]management.ResourceServerScope{
+			{Value: auth0.String("read:foo"), Description: auth0.String("Can read Foo")},
+			{Value: auth0.String("create:foo"), Description: auth0.String("Can create Foo")},
+		},
+	}
+	require.NoError(t, api.ResourceServer.Create(ctx, resourceServer))
+
+	role := &management.Role{Name: auth0.String("Acceptance Test Role")}
+	require.NoError(t, api.Role.Create(ctx, role))
+	assert.Equal(t, "tenant", role.GetType())
+
+	err := api.Role.AssociatePermissions(ctx, role.GetID(), []*management.Permission{
+		{Name: auth0.String("read:foo"), ResourceServerIdentifier: resourceServer.Identifier},
+		{Name: auth0.String("create:foo"), ResourceServerIdentifier: resourceServer.Identifier},
+	})
+	require.NoError(t, err)
+
+	err = api.Role.AssociatePermissions(ctx, role.GetID(), []*management.Permission{
+		{Name: auth0.String("delete:foo"), ResourceServerIdentifier: resourceServer.Identifier},
+	})
+	assertStatus(t, err, http.StatusBadRequest)
+
+	err = api.Role.RemovePermissions(ctx, role.GetID(), []*management.Permission{
+		{Name: auth0.String("create:foo"), ResourceServerIdentifier: resourceServer.Identifier},
+	})
+	require.NoError(t, err)
+
+	permissions, err := api.Role.Permissions(ctx, role.GetID())
+	require.NoError(t, err)
+	require.Len(t, permissions.Permissions, 1)
+	assert.Equal(t, "read:foo", permissions.Permissions[0].GetName())
+	assert.Equal(t, "Acceptance Test", permissions.Permissions[0].GetResourceServerName())
+	assert.Equal(t, "Can read Foo", permissions.Permissions[0].GetDescription())
+
+	user := &management.User{
+		Connection: auth0.String("Username-Password-Authentication"),
+		Email:      auth0.String("test@acceptance.test.com"),
+		Password:   auth0.String("passpass$12$12"),
+	}
+	require.NoError(t, api.User.Create(ctx, user))
+
+	readUser, err := api.User.Read(ctx, user.GetID())
+	require.NoError(t, err)
+	assert.Nil(t, readUser.Password)
+	assert.Nil(t, readUser.Connection)
+	assert.Equal(t, "Username-Password-Authentication", readUser.Identities[0].GetConnection())
+
+	err = api.User.Create(ctx, &management.User{
+		Connection: auth0.String("Username-Password-Authentication"),
+		Email:      auth0.String("TEST@acceptance.test.com"),
+	})
+	assertStatus(t, err, http.StatusConflict)
+
+	require.NoError(t, api.User.AssignRoles(ctx, user.GetID(), []*management.Role{role}))
+
+	roles, err := api.User.Roles(ctx, user.GetID())
+	require.NoError(t, err)
+	require.Len(t, roles.Roles, 1)
+	assert.Equal(t, "Acceptance Test Role", roles.Roles[0].GetName())
+
+	roleUsers, err := api.Role.Users(ctx, role.GetID())
+	require.NoError(t, err)
+	require.Len(t, roleUsers.Users, 1)
+	assert.Equal(t, user.GetID(), roleUsers.Users[0].GetID())
+
+	require.NoError(t, api.Role.Delete(ctx, role.GetID()))
+
+	roles, err = api.User.Roles(ctx, user.GetID())
+	require.NoError(t, err)
+	assert.Empty(t, roles.Roles)
+}
+
+func TestServer_Organizations(t *testing.T) {
+	ctx := context.Background()
+	api := newTestAPI(t)
+
+	organization := &management.Organization{Name: auth0.String("acceptance-test-org")}
+	require.NoError(t, api.Organization.Create(ctx, organization))
+
+	readOrganization, err := api.Organization.ReadByName(ctx, "acceptance-test-org")
+	require.NoError(t, err)
+	assert.Equal(t, organization.GetID(), readOrganization.GetID())
+
+	user := &management.User{
+		Connection: auth0.String("Username-Password-Authentication"),
+		Email:      auth0.String("member@acceptance.test.com"),
+		Name:       auth0.String("Member"),
+	}
+	require.NoError(t, api.User.Create(ctx, user))
+
+	role := &management.Role{Name: auth0.String("Reader")}
+	require.NoError(t, api.Role.Create(ctx, role))
+
+	err = api.Organization.AssignMemberRoles(ctx, organization.GetID(), user.GetID(), []string{role.GetID()})
+	assertStatus(t, err, http.StatusBadRequest)
+
+	require.NoError(t, api.Organization.AddMembers(ctx, organization.GetID(), []string{user.GetID()}))
+	require.NoError(t, api.Organization.AssignMemberRoles(ctx, organization.GetID(), user.GetID(), []string{role.GetID()}))
+
+	members, err := api.Organization.Members(
+		ctx,
+		organization.GetID(),
+		management.IncludeFields("user_id", "name", "email", "roles"),
+	)
+	require.NoError(t, err)
+	require.Len(t, members.Members, 1)
+	assert.Equal(t, user.GetID(), members.Members[0].GetUserID())
+	assert.Equal(t, "Member", members.Members[0].GetName())
+	require.Len(t, members.Members[0].Roles, 1)
+	assert.Equal(t, role.GetID(), members.Members[0].Roles[0].GetID())
+
+	memberRoles, err := api.Organization.MemberRoles(ctx, organization.GetID(), user.GetID())
+	require.NoError(t, err)
+	require.Len(t, memberRoles.Roles, 1)
+	assert.Equal(t, "Reader", memberRoles.Roles[0].GetName())
+
+	require.NoError(t, api.User.Delete(ctx, user.GetID()))
+
+	members, err = api.Organization.Members(ctx, organization.GetID())
+	require.NoError(t, err)
+	assert.Empty(t, members.Members)
+
+	require.NoError(t, api.Organization.Delete(ctx, organization.GetID()))
+
+	_, err = api.Organization.ReadByName(ctx, "acceptance-test-org")
+	assertStatus(t, err, http.StatusNotFound)
+}
+
+func TestServer_ClientGrants(t *testing.T) {
+	ctx := context.Background()
+	api := newTestAPI(t)
+
+	client := &management.Client{Name: auth0.String("Acceptance Test")}
+	require.NoError(t, api.Client.Create(ctx, client))
+
+	clientGrant := &management.ClientGrant{
+		ClientID: client.ClientID,
+		Audience: auth0.String("https://uat.api.terraform-provider-auth0.com"),
+		Scope:    &[]string{"read:foo"},
+	}
+	require.NoError(t, api.ClientGrant.Create(ctx, clientGrant))
+	assert.Equal(t, "client", clientGrant.GetSubjectType())
+
+	err := api.ClientGrant.Create(ctx, &management.ClientGrant{
+		ClientID: client.ClientID,
+		Audience: auth0.String("https://uat.api.terraform-provider-auth0.com"),
+		Scope:    &[]string{},
+	})
+	assertStatus(t, err, http.StatusConflict)
+
+	clientGrants, err := api.ClientGrant.List(
+		ctx,
+		management.Parameter("audience", "https://uat.api.terraform-provider-auth0.com"),
+		management.Parameter("client_id", client.GetClientID()),
+	)
+	require.NoError(t, err)
+	require.Len(t, clientGrants.ClientGrants, 1)
+	assert.Equal(t, clientGrant.GetID(), clientGrants.ClientGrants[0].GetID())
+
+	organization := &management.Organization{Name: auth0.String("acceptance-test-org")}
+	require.NoError(t, api.Organization.Create(ctx, organization))
+	require.NoError(t, api.Organization.AssociateClientGrant(ctx, organization.GetID(), clientGrant.GetID()))
+
+	organizationClientGrants, err := api.Organization.ClientGrants(ctx, organization.GetID())
+	require.NoError(t, err)
+	require.Len(t, organizationClientGrants.ClientGrants, 1)
+	assert.Equal(t, []string{"read:foo"}, organizationClientGrants.ClientGrants[0].GetScope())
+
+	require.NoError(t, api.Client.Delete(ctx, client.GetClientID()))
+
+	_, err = api.ClientGrant.Read(ctx, clientGrant.GetID())
+	assertStatus(t, err, http.StatusNotFound)
+
+	organizationClientGrants, err = api.Organization.ClientGrants(ctx, organization.GetID())
+	require.NoError(t, err)
+	assert.Empty(t, organizationClientGrants.ClientGrants)
+}
+
+func TestServer_OrganizationConnections(t *testing.T) {
+	ctx := context.Background()
+	api := newTestAPI(t)
+
+	organization := &management.Organization{Name: auth0.String("acceptance-test-org")}
+	require.NoError(t, api.Organization.Create(ctx, organization))
+
+	connection := &management.Connection{
+		Name:     auth0.String("Acceptance-Test-Connection"),
+		Strategy: auth0.String("auth0"),
+	}
+	require.NoError(t, api.Connection.Create(ctx, connection))
+
+	err := api.Organization.AddConnection(ctx, organization.GetID(), &management.OrganizationConnection{
+		ConnectionID: auth0.String("con_doesnotexist"),
+	})
+	assertStatus(t, err, http.StatusNotFound)
+
+	enabledConnection := &management.OrganizationConnection{
+		ConnectionID:            connection.ID,
+		AssignMembershipOnLogin: auth0.Bool(true),
+	}
+	require.NoError(t, api.Organization.AddConnection(ctx, organization.GetID(), enabledConnection))
+	assert.True(t, enabledConnection.GetShowAsButton())
+	assert.Equal(t, "Acceptance-Test-Connection", enabledConnection.GetConnection().GetName())
+
+	require.NoError(t, api.Organization.UpdateConnection(ctx, organization.GetID(), connection.GetID(), &management.OrganizationConnection{
+		AssignMembershipOnLogin: auth0.Bool(false),
+	}))
+
+	readConnection, err := api.Organization.Connection(ctx, organization.GetID(), connection.GetID())
+	require.NoError(t, err)
+	assert.False(t, readConnection.GetAssignMembershipOnLogin())
+	assert.Equal(t, "auth0", readConnection.GetConnection().GetStrategy())
+
+	connections, err := api.Organization.Connections(ctx, organization.GetID())
+	require.NoError(t, err)
+	require.Len(t, connections.OrganizationConnections, 1)
+
+	require.NoError(t, api.Connection.Delete(ctx, connection.GetID()))
+
+	connections, err = api.Organization.Connections(ctx, organization.GetID())
+	require.NoError(t, err)
+	assert.Empty(t, connections.OrganizationConnections)
+
+	_, err = api.Organization.Connection(ctx, organization.GetID(), connection.GetID())
+	assertStatus(t, err, http.StatusNotFound)
+}
+
+func TestServer_UnsupportedEndpoints(t *testing.T) {
+	api := newTestAPI(t)
+
+	_, err := api.Action.List(context.Background())
+	assertStatus(t, err, http.StatusNotImplemented)
+	assert.ErrorContains(t, err, "The fake Management API doesn't implement GET /api/v2/actions/actions.")
+}
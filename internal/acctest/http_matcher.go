@@ -0,0 +1,322 @@
+package acctest
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"gopkg.in/dnaeon/go-vcr.v3/cassette"
+	"gopkg.in/dnaeon/go-vcr.v3/recorder"
+)
+
+// redactedValue replaces the sensitive data in the recordings.
+const redactedValue = "[REDACTED]"
+
+// cassetteRecorder records and replays the http interactions of a test,
+// matching the requests with the recorded ones by their method and URL. When
+// matching the bodies is enabled, the JSON body must match too, so that a
+// change to the payloads sent by the provider fails the tests replaying the
+// recordings made before it.
+type cassetteRecorder struct {
+	*recorder.Recorder
+
+	t       testing.TB
+	matcher *requestMatcher
+}
+
+// GetDefaultClient returns an http client using the recorder as its transport.
+func (r *cassetteRecorder) GetDefaultClient() *http.Client {
+	return &http.Client{Transport: r}
+}
+
+// RoundTrip replays the recorded interaction matching the request. When no
+// interaction matches, but some have the same method and URL and a different
+// body, the error lists the differences with the closest one. In diff mode,
+// the differences are reported as a test error instead, and the recorded
+// interaction is replayed anyway so the test carries on, reporting every
+// request that differs from the recordings in a single run.
+func (r *cassetteRecorder) RoundTrip(request *http.Request) (*http.Response, error) {
+	response, err := r.Recorder.RoundTrip(request)
+
+	mismatch := r.matcher.takeMismatch(request)
+	if !errors.Is(err, cassette.ErrInteractionNotFound) || mismatch == nil {
+		return response, err
+	}
+
+	if !recordingsDiffModeIsEnabled() {
+		return nil, fmt.Errorf(
+			"%w: the body of %s %s differs from the recording:\n%s",
+			err,
+			request.Method,
+			request.URL,
+			mismatch,
+		)
+	}
+
+	r.t.Errorf("The body of %s %s differs from the recording:\n%s", request.Method, request.URL, mismatch)
+
+	r.matcher.acceptRecordedBody(request, mismatch.recordedBody)
+	defer r.matcher.takeMismatch(request)
+
+	return r.Recorder.RoundTrip(request)
+}
+
+// recordingsDiffModeIsEnabled checks whether the requests sent with a
+// different body than the recorded one should be reported and replayed.
+func recordingsDiffModeIsEnabled() bool {
+	diffMode := os.Getenv("AUTH0_HTTP_RECORDINGS_DIFF")
+	return diffMode == "true" || diffMode == "1" || diffMode == "on"
+}
+
+// recordingsBodyMatchingIsEnabled checks whether the requests must be sent with
+// the same body as the recorded ones to match them, which diff mode implies.
+func recordingsBodyMatchingIsEnabled() bool {
+	bodyMatching := os.Getenv("AUTH0_HTTP_RECORDINGS_MATCH_BODY")
+	return bodyMatching == "true" || bodyMatching == "1" || bodyMatching == "on" || recordingsDiffModeIsEnabled()
+}
+
+// requestMatcher matches the requests with the recorded ones by their method
+// and URL, and by their body when matchBody is set, keeping the differences with the closest recorded request that
+// only differs by its body, i.e. the one with the fewest differences, until the
+// round trip of the request is over. When several recorded requests share the
+// same URL, e.g. the updates of a resource in successive steps of a test, the
+// differences are reported against the step the request most likely belongs to.
+type requestMatcher struct {
+	matchBody bool
+
+	mu         sync.Mutex
+	mismatches map[*http.Request]*bodyMismatch
+}
+
+// bodyMismatch holds the differences between the body
+// of a request and the body of a recorded request.
+type bodyMismatch struct {
+	recordedBody string
+	differences  []string
+	accepted     bool
+}
+
+func (m *bodyMismatch) String() string {
+	return "  " + strings.Join(m.differences, "\n  ")
+}
+
+func newRequestMatcher() *requestMatcher {
+	return &requestMatcher{
+		matchBody:  recordingsBodyMatchingIsEnabled(),
+		mismatches: map[*http.Request]*bodyMismatch{},
+	}
+}
+
+// Match is the cassette.MatcherFunc of the recorder.
+func (m *requestMatcher) Match(request *http.Request, recorded cassette.Request) bool {
+	if request.Method != recorded.Method || request.URL.String() != recorded.URL {
+		return false
+	}
+
+	if !m.matchBody {
+		return true
+	}
+
+	body, err := readRequestBody(request)
+	if err != nil {
+		return false
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	mismatch := m.mismatches[request]
+	if mismatch != nil && mismatch.accepted {
+		return recorded.Body == mismatch.recordedBody
+	}
+
+	differences := diffRequestBodies(recorded.Body, body)
+	if len(differences) == 0 {
+		return true
+	}
+
+	if mismatch == nil || len(differences) < len(mismatch.differences) {
+		m.mismatches[request] = &bodyMismatch{
+			recordedBody: recorded.Body,
+			differences:  differences,
+		}
+	}
+
+	return false
+}
+
+func (m *requestMatcher) takeMismatch(request *http.Request) *bodyMismatch {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	mismatch := m.mismatches[request]
+	delete(m.mismatches, request)
+
+	return mismatch
+}
+
+func (m *requestMatcher) acceptRecordedBody(request *http.Request, recordedBody string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.mismatches[request] = &bodyMismatch{
+		recordedBody: recordedBody,
+		accepted:     true,
+	}
+}
+
+// readRequestBody reads the body of the request,
+// leaving it readable again for the next matches.
+func readRequestBody(request *http.Request) (string, error) {
+	if request.Body == nil || request.Body == http.NoBody {
+		return "", nil
+	}
+
+	body, err := io.ReadAll(request.Body)
+	if err != nil {
+		return "", err
+	}
+	_ = request.Body.Close()
+
+	request.Body = io.NopCloser(bytes.NewReader(body))
+
+	return string(body), nil
+}
+
+// diffRequestBodies returns the differences between the recorded body of a
+// request and the body sent, one line per field. When both bodies are JSON,
+// the order of the keys doesn't matter, and the recorded values that were
+// redacted match any value. Other bodies must be equal, but for whitespace.
+func diffRequestBodies(recordedBody, sentBody string) []string {
+	recordedBody = strings.TrimSpace(recordedBody)
+	sentBody = strings.TrimSpace(sentBody)
+
+	if recordedBody == sentBody {
+		return nil
+	}
+
+	recorded, recordedErr := decodeJSON(recordedBody)
+	sent, sentErr := decodeJSON(sentBody)
+	if recordedErr != nil || sentErr != nil {
+		return []string{fmt.Sprintf("body: recorded %q, sent %q", recordedBody, sentBody)}
+	}
+
+	var differences []string
+	diffJSON("", recorded, sent, &differences)
+
+	return differences
+}
+
+func decodeJSON(body string) (interface{}, error) {
+	if body == "" {
+		return nil, nil
+	}
+
+	decoder := json.NewDecoder(strings.NewReader(body))
+	decoder.UseNumber()
+
+	var value interface{}
+	if err := decoder.Decode(&value); err != nil {
+		return nil, err
+	}
+
+	if decoder.More() {
+		return nil, errors.New("unexpected data after the JSON value")
+	}
+
+	return value, nil
+}
+
+func diffJSON(path string, recorded, sent interface{}, differences *[]string) {
+	if recordedString, ok := recorded.(string); ok && strings.Contains(recordedString, redactedValue) {
+		return
+	}
+
+	recordedObject, recordedIsObject := recorded.(map[string]interface{})
+	sentObject, sentIsObject := sent.(map[string]interface{})
+	if recordedIsObject && sentIsObject {
+		for _, key := range unionOfKeys(recordedObject, sentObject) {
+			recordedValue, isRecorded := recordedObject[key]
+			sentValue, isSent := sentObject[key]
+			keyPath := joinJSONPath(path, key)
+
+			switch {
+			case !isSent:
+				*differences = append(*differences, fmt.Sprintf("%s: recorded %s, not sent", keyPath, encodeJSON(recordedValue)))
+			case !isRecorded:
+				*differences = append(*differences, fmt.Sprintf("%s: not recorded, sent %s", keyPath, encodeJSON(sentValue)))
+			default:
+				diffJSON(keyPath, recordedValue, sentValue, differences)
+			}
+		}
+		return
+	}
+
+	recordedArray, recordedIsArray := recorded.([]interface{})
+	sentArray, sentIsArray := sent.([]interface{})
+	if recordedIsArray && sentIsArray {
+		for index := 0; index < len(recordedArray) || index < len(sentArray); index++ {
+			indexPath := path + "[" + strconv.Itoa(index) + "]"
+
+			switch {
+			case index >= len(sentArray):
+				*differences = append(*differences, fmt.Sprintf("%s: recorded %s, not sent", indexPath, encodeJSON(recordedArray[index])))
+			case index >= len(recordedArray):
+				*differences = append(*differences, fmt.Sprintf("%s: not recorded, sent %s", indexPath, encodeJSON(sentArray[index])))
+			default:
+				diffJSON(indexPath, recordedArray[index], sentArray[index], differences)
+			}
+		}
+		return
+	}
+
+	if !reflect.DeepEqual(recorded, sent) {
+		if path == "" {
+			path = "body"
+		}
+		*differences = append(*differences, fmt.Sprintf("%s: recorded %s, sent %s", path, encodeJSON(recorded), encodeJSON(sent)))
+	}
+}
+
+func unionOfKeys(objects ...map[string]interface{}) []string {
+	seen := map[string]bool{}
+	var keys []string
+
+	for _, object := range objects {
+		for key := range object {
+			if !seen[key] {
+				seen[key] = true
+				keys = append(keys, key)
+			}
+		}
+	}
+
+	sort.Strings(keys)
+
+	return keys
+}
+
+func joinJSONPath(path, key string) string {
+	if path == "" {
+		return key
+	}
+	return path + "." + key
+}
+
+func encodeJSON(value interface{}) string {
+	encoded, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprintf("%v", value)
+	}
+	return string(encoded)
+}
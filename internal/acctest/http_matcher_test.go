@@ -0,0 +1,280 @@
+package acctest
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"path"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/dnaeon/go-vcr.v3/cassette"
+	"gopkg.in/dnaeon/go-vcr.v3/recorder"
+)
+
+func TestDiffRequestBodies(t *testing.T) {
+	var testCases = []struct {
+		name         string
+		recordedBody string
+		sentBody     string
+		expected     []string
+	}{
+		{
+			name:         "empty bodies",
+			recordedBody: "",
+			sentBody:     "",
+			expected:     nil,
+		},
+		{
+			name:         "keys in a different order",
+			recordedBody: `{"name":"Acceptance Test","options":{"b":2,"a":1}}` + "\n",
+			sentBody:     `{"options":{"a":1,"b":2},"name":"Acceptance Test"}`,
+			expected:     nil,
+		},
+		{
+			name:         "redacted values",
+			recordedBody: `{"client_secret":"[REDACTED]","signing_keys":[{"cert":"-----BEGIN CERTIFICATE-----[REDACTED]"}]}`,
+			sentBody:     `{"client_secret":"secret","signing_keys":[{"cert":"-----BEGIN CERTIFICATE-----MIIC"}]}`,
+			expected:     nil,
+		},
+		{
+			name:         "changed, missing and extra fields",
+			recordedBody: `{"name":"Acceptance Test","app_type":"spa","jwt_configuration":{"alg":"RS256","lifetime_in_seconds":3600}}`,
+			sentBody:     `{"name":"Acceptance Test - Updated","jwt_configuration":{"alg":"RS256"},"is_first_party":true}`,
+			expected: []string{
+				`app_type: recorded "spa", not sent`,
+				`is_first_party: not recorded, sent true`,
+				`jwt_configuration.lifetime_in_seconds: recorded 3600, not sent`,
+				`name: recorded "Acceptance Test", sent "Acceptance Test - Updated"`,
+			},
+		},
+		{
+			name:         "changed and extra items",
+			recordedBody: `{"callbacks":["https://example.com/callback"]}`,
+			sentBody:     `{"callbacks":["https://example.com/login","https://example.com/callback"]}`,
+			expected: []string{
+				`callbacks[0]: recorded "https://example.com/callback", sent "https://example.com/login"`,
+				`callbacks[1]: not recorded, sent "https://example.com/callback"`,
+			},
+		},
+		{
+			name:         "null instead of a value",
+			recordedBody: `{"description":null}`,
+			sentBody:     `{"description":""}`,
+			expected:     []string{`description: recorded null, sent ""`},
+		},
+		{
+			name:         "different JSON types",
+			recordedBody: `[{"client_id":"1"}]`,
+			sentBody:     `{"client_id":"1"}`,
+			expected:     []string{`body: recorded [{"client_id":"1"}], sent {"client_id":"1"}`},
+		},
+		{
+			name:         "bodies that aren't JSON",
+			recordedBody: "grant_type=client_credentials",
+			sentBody:     "grant_type=password",
+			expected:     []string{`body: recorded "grant_type=client_credentials", sent "grant_type=password"`},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			assert.Equal(t, testCase.expected, diffRequestBodies(testCase.recordedBody, testCase.sentBody))
+		})
+	}
+}
+
+// testingT records the errors reported by the recorder in diff mode.
+type testingT struct {
+	testing.TB
+	errors []string
+}
+
+func (t *testingT) Errorf(format string, args ...interface{}) {
+	t.errors = append(t.errors, fmt.Sprintf(format, args...))
+}
+
+func newTestCassetteRecorder(t *testing.T, interactions ...*cassette.Interaction) (*cassetteRecorder, *testingT) {
+	t.Helper()
+
+	cassetteName := path.Join(t.TempDir(), "TestCassette")
+
+	recordedCassette := cassette.New(cassetteName)
+	for _, interaction := range interactions {
+		recordedCassette.AddInteraction(interaction)
+	}
+	require.NoError(t, recordedCassette.Save())
+
+	recorderTransport, err := recorder.NewWithOptions(&recorder.Options{
+		CassetteName:       cassetteName,
+		Mode:               recorder.ModeReplayOnly,
+		SkipRequestLatency: true,
+	})
+	require.NoError(t, err)
+
+	matcher := newRequestMatcher()
+	recorderTransport.SetMatcher(matcher.Match)
+
+	reporter := &testingT{TB: t}
+
+	return &cassetteRecorder{Recorder: recorderTransport, t: reporter, matcher: matcher}, reporter
+}
+
+func newTestInteraction(method, url, requestBody, responseBody string) *cassette.Interaction {
+	return &cassette.Interaction{
+		Request: cassette.Request{
+			Method: method,
+			URL:    url,
+			Body:   requestBody,
+		},
+		Response: cassette.Response{
+			Body:   responseBody,
+			Code:   http.StatusOK,
+			Status: "200 OK",
+		},
+	}
+}
+
+func sendTestRequest(t *testing.T, client *http.Client, method, url, body string) (string, error) {
+	t.Helper()
+
+	request, err := http.NewRequestWithContext(context.Background(), method, url, strings.NewReader(body))
+	require.NoError(t, err)
+
+	response, err := client.Do(request)
+	if err != nil {
+		return "", err
+	}
+	defer response.Body.Close()
+
+	responseBody, err := io.ReadAll(response.Body)
+	require.NoError(t, err)
+
+	return string(responseBody), nil
+}
+
+func TestCassetteRecorder(t *testing.T) {
+	const clientsURL = "https://" + RecordingsDomain + "/api/v2/clients"
+
+	t.Run("it replays the interaction with the same URL whatever its body by default", func(t *testing.T) {
+		t.Setenv("AUTH0_HTTP_RECORDINGS_MATCH_BODY", "")
+		t.Setenv("AUTH0_HTTP_RECORDINGS_DIFF", "")
+
+		httpRecorder, reporter := newTestCassetteRecorder(
+			t,
+			newTestInteraction(http.MethodPost, clientsURL, `{"name":"First"}`, `{"client_id":"1"}`),
+		)
+
+		response, err := sendTestRequest(t, httpRecorder.GetDefaultClient(), http.MethodPost, clientsURL, `{"name":"Updated"}`)
+		require.NoError(t, err)
+		assert.Equal(t, `{"client_id":"1"}`, response)
+
+		assert.Empty(t, reporter.errors)
+		assert.Empty(t, httpRecorder.matcher.mismatches)
+	})
+
+	t.Run("it replays the interaction matching the body", func(t *testing.T) {
+		t.Setenv("AUTH0_HTTP_RECORDINGS_MATCH_BODY", "on")
+
+		httpRecorder, reporter := newTestCassetteRecorder(
+			t,
+			newTestInteraction(http.MethodPost, clientsURL, `{"name":"First"}`, `{"client_id":"1"}`),
+			newTestInteraction(http.MethodPost, clientsURL, `{"name":"Second","client_secret":"[REDACTED]"}`, `{"client_id":"2"}`),
+		)
+
+		response, err := sendTestRequest(t, httpRecorder.GetDefaultClient(), http.MethodPost, clientsURL, `{"client_secret":"secret","name":"Second"}`)
+		require.NoError(t, err)
+		assert.Equal(t, `{"client_id":"2"}`, response)
+
+		response, err = sendTestRequest(t, httpRecorder.GetDefaultClient(), http.MethodPost, clientsURL, `{"name":"First"}`)
+		require.NoError(t, err)
+		assert.Equal(t, `{"client_id":"1"}`, response)
+
+		assert.Empty(t, reporter.errors)
+		assert.Empty(t, httpRecorder.matcher.mismatches)
+	})
+
+	t.Run("it fails with the differences when the body changed", func(t *testing.T) {
+		t.Setenv("AUTH0_HTTP_RECORDINGS_MATCH_BODY", "on")
+		t.Setenv("AUTH0_HTTP_RECORDINGS_DIFF", "")
+
+		httpRecorder, reporter := newTestCassetteRecorder(
+			t,
+			newTestInteraction(http.MethodPost, clientsURL, `{"name":"First","app_type":"spa"}`, `{"client_id":"1"}`),
+		)
+
+		_, err := sendTestRequest(t, httpRecorder.GetDefaultClient(), http.MethodPost, clientsURL, `{"name":"First"}`)
+		assert.ErrorIs(t, err, cassette.ErrInteractionNotFound)
+		assert.ErrorContains(
+			t,
+			err,
+			"the body of POST "+clientsURL+" differs from the recording:\n"+`  app_type: recorded "spa", not sent`,
+		)
+
+		assert.Empty(t, reporter.errors)
+		assert.Empty(t, httpRecorder.matcher.mismatches)
+	})
+
+	t.Run("it fails with the differences with the closest interaction", func(t *testing.T) {
+		t.Setenv("AUTH0_HTTP_RECORDINGS_MATCH_BODY", "on")
+		t.Setenv("AUTH0_HTTP_RECORDINGS_DIFF", "")
+
+		httpRecorder, reporter := newTestCassetteRecorder(
+			t,
+			newTestInteraction(http.MethodPatch, clientsURL+"/1", `{"name":"First","app_type":"native"}`, `{"client_id":"1"}`),
+			newTestInteraction(http.MethodPatch, clientsURL+"/1", `{"name":"Second","app_type":"native"}`, `{"client_id":"1"}`),
+			newTestInteraction(http.MethodPatch, clientsURL+"/1", `{"name":"Third","app_type":"native"}`, `{"client_id":"1"}`),
+		)
+
+		_, err := sendTestRequest(t, httpRecorder.GetDefaultClient(), http.MethodPatch, clientsURL+"/1", `{"name":"Third","app_type":"spa"}`)
+		assert.ErrorIs(t, err, cassette.ErrInteractionNotFound)
+		assert.ErrorContains(
+			t,
+			err,
+			"the body of PATCH "+clientsURL+"/1 differs from the recording:\n"+`  app_type: recorded "native", sent "spa"`,
+		)
+		assert.NotContains(t, err.Error(), "name:")
+
+		assert.Empty(t, reporter.errors)
+		assert.Empty(t, httpRecorder.matcher.mismatches)
+	})
+
+	t.Run("it fails without differences when no interaction has the same URL", func(t *testing.T) {
+		httpRecorder, _ := newTestCassetteRecorder(
+			t,
+			newTestInteraction(http.MethodPost, clientsURL, `{"name":"First"}`, `{"client_id":"1"}`),
+		)
+
+		_, err := sendTestRequest(t, httpRecorder.GetDefaultClient(), http.MethodGet, clientsURL+"/1", "")
+		assert.ErrorIs(t, err, cassette.ErrInteractionNotFound)
+		assert.NotContains(t, err.Error(), "differs from the recording")
+	})
+
+	t.Run("it reports the differences and replays the interaction in diff mode", func(t *testing.T) {
+		t.Setenv("AUTH0_HTTP_RECORDINGS_MATCH_BODY", "")
+		t.Setenv("AUTH0_HTTP_RECORDINGS_DIFF", "on")
+
+		httpRecorder, reporter := newTestCassetteRecorder(
+			t,
+			newTestInteraction(http.MethodPatch, clientsURL+"/1", `{"name":"First","callbacks":[]}`, `{"client_id":"1"}`),
+			newTestInteraction(http.MethodPatch, clientsURL+"/1", `{"name":"Second"}`, `{"client_id":"1","name":"Second"}`),
+		)
+
+		response, err := sendTestRequest(t, httpRecorder.GetDefaultClient(), http.MethodPatch, clientsURL+"/1", `{"name":"Updated","callbacks":[]}`)
+		require.NoError(t, err)
+		assert.Equal(t, `{"client_id":"1"}`, response)
+
+		response, err = sendTestRequest(t, httpRecorder.GetDefaultClient(), http.MethodPatch, clientsURL+"/1", `{"name":"Second"}`)
+		require.NoError(t, err)
+		assert.Equal(t, `{"client_id":"1","name":"Second"}`, response)
+
+		assert.Equal(t, []string{
+			"The body of PATCH " + clientsURL + "/1 differs from the recording:\n" +
+				`  name: recorded "First", sent "Updated"`,
+		}, reporter.errors)
+		assert.Empty(t, httpRecorder.matcher.mismatches)
+	})
+}
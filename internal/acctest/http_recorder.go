@@ -26,7 +26,7 @@ const (
 )
 
 // NewHTTPRecorder creates a new instance of our http recorder used in tests.
-func newHTTPRecorder(t *testing.T) *recorder.Recorder {
+func newHTTPRecorder(t *testing.T) *cassetteRecorder {
 	t.Helper()
 
 	recorderTransport, err := recorder.NewWithOptions(
@@ -38,6 +38,9 @@ func newHTTPRecorder(t *testing.T) *recorder.Recorder {
 	)
 	require.NoError(t, err)
 
+	matcher := newRequestMatcher()
+	recorderTransport.SetMatcher(matcher.Match)
+
 	removeSensitiveDataFromRecordings(t, recorderTransport)
 
 	t.Cleanup(func() {
@@ -45,7 +48,11 @@ func newHTTPRecorder(t *testing.T) *recorder.Recorder {
 		require.NoError(t, err)
 	})
 
-	return recorderTransport
+	return &cassetteRecorder{
+		Recorder: recorderTransport,
+		t:        t,
+		matcher:  matcher,
+	}
 }
 
 func cassetteName(testName string) string {
@@ -130,7 +137,7 @@ func redactSensitiveDataInClient(t *testing.T, i *cassette.Interaction, domain s
 			return
 		}
 
-		redacted := "[REDACTED]"
+		redacted := redactedValue
 
 		// Handle list response.
 		if readList {
@@ -53,21 +53,31 @@ func readActionForDataSource(ctx context.Context, data *schema.ResourceData, met
 	// Else use Get Actions API and filter by name.
 	name := data.Get("name").(string)
 
+	actions, err := findActionsByName(ctx, api, name)
+
+	// Handles API error.
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if len(actions) == 1 {
+		data.SetId(actions[0].GetID())
+		return diag.FromErr(flattenAction(data, actions[0]))
+	}
+	return diag.Errorf("No action found with \"name\" = %q", name)
+}
+
+// findActionsByName returns the actions with the given name.
+func findActionsByName(ctx context.Context, api *management.Management, name string) ([]*management.Action, error) {
 	// The Actions List API works on an exact name match.
 	// Therefore, no need to create a List of Actions.
 	// Either it's an exact match, or no match.
 	// There are other params like deployed and triggerId that can be used as query param via API,
 	// but they are not part of this implementation.
 	actions, err := api.Action.List(ctx, management.Parameter("actionName", name))
-
-	// Handles API error.
 	if err != nil {
-		return diag.FromErr(err)
+		return nil, err
 	}
 
-	if len(actions.Actions) == 1 {
-		data.SetId(actions.Actions[0].GetID())
-		return diag.FromErr(flattenAction(data, actions.Actions[0]))
-	}
-	return diag.Errorf("No action found with \"name\" = %q", name)
+	return actions.Actions, nil
 }
@@ -0,0 +1,27 @@
+package action
+
+import (
+	"context"
+
+	"github.com/auth0/terraform-provider-auth0/internal/config"
+	internalSchema "github.com/auth0/terraform-provider-auth0/internal/schema"
+)
+
+// ImportIDResolvers resolve the human-readable import IDs of actions, e.g. "name:add-claims".
+var ImportIDResolvers = internalSchema.ImportIDResolvers{
+	"name": resolveActionIDByName,
+}
+
+func resolveActionIDByName(ctx context.Context, meta interface{}, name string) (string, error) {
+	actions, err := findActionsByName(ctx, meta.(*config.Config).GetAPI(), name)
+	if err != nil {
+		return "", err
+	}
+
+	ids := make([]string, 0, len(actions))
+	for _, action := range actions {
+		ids = append(ids, action.GetID())
+	}
+
+	return internalSchema.UniqueImportID("action", "name", name, ids)
+}
@@ -0,0 +1,98 @@
+package action
+
+import (
+	"context"
+
+	"github.com/auth0/go-auth0/management"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/auth0/terraform-provider-auth0/internal/config"
+	internalSchema "github.com/auth0/terraform-provider-auth0/internal/schema"
+)
+
+// NewListResource will return a new auth0_action list resource.
+func NewListResource() list.ListResource {
+	return internalSchema.NewListResource(internalSchema.ListResourceConfig{
+		TypeName: "_action",
+		Resource: NewResource,
+		Schema: schema.Schema{
+			MarkdownDescription: "With this list resource, you can find the actions of the tenant, " +
+				"e.g. to import them with `terraform query`.",
+			Attributes: map[string]schema.Attribute{
+				"name": schema.StringAttribute{
+					Optional:            true,
+					MarkdownDescription: "Filter actions by name (exact match).",
+				},
+				"trigger_id": schema.StringAttribute{
+					Optional:            true,
+					MarkdownDescription: "Filter actions by the ID of a trigger they support, e.g. `post-login`.",
+				},
+				"deployed": schema.BoolAttribute{
+					Optional:            true,
+					MarkdownDescription: "Only list deployed actions.",
+				},
+			},
+		},
+		List: listActions,
+	})
+}
+
+type listActionsModel struct {
+	Name      types.String `tfsdk:"name"`
+	TriggerID types.String `tfsdk:"trigger_id"`
+	Deployed  types.Bool   `tfsdk:"deployed"`
+}
+
+func listActions(
+	ctx context.Context,
+	filters tfsdk.Config,
+	meta interface{},
+	yield func(internalSchema.ListItem) bool,
+) diag.Diagnostics {
+	api := meta.(*config.Config).GetAPI()
+
+	var data listActionsModel
+	diagnostics := filters.Get(ctx, &data)
+	if diagnostics.HasError() {
+		return diagnostics
+	}
+
+	params := []management.RequestOption{
+		management.PerPage(100),
+	}
+
+	if name := data.Name.ValueString(); name != "" {
+		params = append(params, management.Parameter("actionName", name))
+	}
+	if triggerID := data.TriggerID.ValueString(); triggerID != "" {
+		params = append(params, management.Parameter("triggerId", triggerID))
+	}
+	if data.Deployed.ValueBool() {
+		params = append(params, management.Parameter("deployed", "true"))
+	}
+
+	var page int
+	for {
+		actions, err := api.Action.List(ctx, append(params, management.Page(page))...)
+		if err != nil {
+			diagnostics.AddError("Failed to List Actions", err.Error())
+			return diagnostics
+		}
+
+		for _, action := range actions.Actions {
+			if !yield(internalSchema.ListItem{ID: action.GetID(), DisplayName: action.GetName()}) {
+				return diagnostics
+			}
+		}
+
+		if !actions.HasNext() {
+			return diagnostics
+		}
+
+		page++
+	}
+}
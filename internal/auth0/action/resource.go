@@ -7,17 +7,18 @@ import (
 
 	"github.com/auth0/go-auth0/management"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"github.com/auth0/terraform-provider-auth0/internal/config"
 	internalError "github.com/auth0/terraform-provider-auth0/internal/error"
+	internalSchema "github.com/auth0/terraform-provider-auth0/internal/schema"
+	"github.com/auth0/terraform-provider-auth0/internal/wait"
 )
 
 // NewResource will return a new auth0_action resource.
 func NewResource() *schema.Resource {
-	return &schema.Resource{
+	return internalSchema.WithIDIdentity(&schema.Resource{
 		CreateContext: createAction,
 		ReadContext:   readAction,
 		UpdateContext: updateAction,
@@ -26,7 +27,7 @@ func NewResource() *schema.Resource {
 			Create: schema.DefaultTimeout(6 * time.Minute),
 		},
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: internalSchema.ImportStateResolvingID(ImportIDResolvers, schema.ImportStatePassthroughContext),
 		},
 		Description: "Actions are secure, tenant-specific, versioned functions written in Node.js " +
 			"that execute at certain points during the Auth0 runtime. Actions are used to customize " +
@@ -204,7 +205,7 @@ func NewResource() *schema.Resource {
 				},
 			},
 		},
-	}
+	})
 }
 
 func createAction(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -275,25 +276,22 @@ func deployAction(ctx context.Context, data *schema.ResourceData, meta interface
 
 	api := meta.(*config.Config).GetAPI()
 
-	err := retry.RetryContext(ctx, data.Timeout(schema.TimeoutCreate), func() *retry.RetryError {
+	err := wait.Until(ctx, wait.Options{
+		Operation: fmt.Sprintf("action %q to be built", data.Get("name").(string)),
+		Timeout:   data.Timeout(schema.TimeoutCreate),
+	}, func(ctx context.Context) (bool, string, error) {
 		action, err := api.Action.Read(ctx, data.Id())
 		if err != nil {
-			return retry.NonRetryableError(err)
+			return false, "", err
 		}
 
 		if action.GetStatus() == management.ActionStatusFailed {
-			return retry.NonRetryableError(
-				fmt.Errorf("action %q failed to build, check the Auth0 UI for errors", action.GetName()),
-			)
-		}
-
-		if action.GetStatus() != management.ActionStatusBuilt {
-			return retry.RetryableError(
-				fmt.Errorf("expected action %q status %q to equal %q", action.GetName(), action.GetStatus(), "built"),
+			return false, action.GetStatus(), fmt.Errorf(
+				"action %q failed to build, check the Auth0 UI for errors", action.GetName(),
 			)
 		}
 
-		return nil
+		return action.GetStatus() == management.ActionStatusBuilt, action.GetStatus(), nil
 	})
 	if err != nil {
 		return fmt.Errorf("action %q never reached built state: %w", data.Get("name").(string), err)
@@ -312,19 +310,20 @@ func deployAction(ctx context.Context, data *schema.ResourceData, meta interface
 }
 
 func waitForActionDeployed(ctx context.Context, data *schema.ResourceData, api *management.Management) error {
-	err := retry.RetryContext(ctx, data.Timeout(schema.TimeoutCreate), func() *retry.RetryError {
+	err := wait.Until(ctx, wait.Options{
+		Operation: fmt.Sprintf("action %q to be deployed", data.Get("name").(string)),
+		Timeout:   data.Timeout(schema.TimeoutCreate),
+	}, func(ctx context.Context) (bool, string, error) {
 		action, err := api.Action.Read(ctx, data.Id())
 		if err != nil {
-			return retry.NonRetryableError(err)
+			return false, "", err
 		}
 
 		if action.GetDeployedVersion() == nil || !action.GetDeployedVersion().Deployed {
-			return retry.RetryableError(
-				fmt.Errorf("action %q not deployed yet", action.GetName()),
-			)
+			return false, "not deployed", nil
 		}
 
-		return nil
+		return true, "deployed", nil
 	})
 	if err != nil {
 		return fmt.Errorf("action %q deploy never completed: %w", data.Get("name").(string), err)
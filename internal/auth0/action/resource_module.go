@@ -9,11 +9,12 @@ import (
 
 	"github.com/auth0/terraform-provider-auth0/internal/config"
 	internalError "github.com/auth0/terraform-provider-auth0/internal/error"
+	internalSchema "github.com/auth0/terraform-provider-auth0/internal/schema"
 )
 
 // NewModuleResource will return a new auth0_action_module resource.
 func NewModuleResource() *schema.Resource {
-	return &schema.Resource{
+	return internalSchema.WithIDIdentity(&schema.Resource{
 		CreateContext: createActionModule,
 		ReadContext:   readActionModule,
 		UpdateContext: updateActionModule,
@@ -183,7 +184,7 @@ func NewModuleResource() *schema.Resource {
 				Description: "Version ID of the module. This value is available if `publish` is set to true.",
 			},
 		},
-	}
+	})
 }
 
 func createActionModule(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -16,13 +16,19 @@ import (
 
 // NewTriggerActionResource will return a new auth0_trigger_action resource.
 func NewTriggerActionResource() *schema.Resource {
-	return &schema.Resource{
+	return internalSchema.WithGroupIdentity(&schema.Resource{
 		CreateContext: createTriggerAction,
 		ReadContext:   readTriggerAction,
 		UpdateContext: updateTriggerAction,
 		DeleteContext: deleteTriggerAction,
 		Importer: &schema.ResourceImporter{
-			StateContext: internalSchema.ImportResourceGroupID("trigger", "action_id"),
+			StateContext: internalSchema.ImportResourceGroupIDResolving(
+				map[string]internalSchema.ImportIDResolvers{
+					"action_id": ImportIDResolvers,
+				},
+				"trigger",
+				"action_id",
+			),
 		},
 		Description: "With this resource, you can bind an action to a trigger. Once an action is created and deployed, it can be attached (i.e. bound) to a trigger so that it will be executed as part of a flow.\n\nOrdering of an action within a specific flow is not currently supported when using this resource; the action will get appended to the end of the flow. To precisely manage ordering, it is advised to either do so with the dashboard UI or with the `auth0_trigger_bindings` resource.",
 		Schema: map[string]*schema.Schema{
@@ -58,7 +64,7 @@ func NewTriggerActionResource() *schema.Resource {
 				Description: "The name for this action within the trigger. This can be useful for distinguishing between multiple instances of the same action bound to a trigger. Defaults to action name when not provided.",
 			},
 		},
-	}
+	}, "trigger", "action_id")
 }
 
 func createTriggerAction(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -10,11 +10,12 @@ import (
 
 	"github.com/auth0/terraform-provider-auth0/internal/config"
 	internalError "github.com/auth0/terraform-provider-auth0/internal/error"
+	internalSchema "github.com/auth0/terraform-provider-auth0/internal/schema"
 )
 
 // NewTriggerActionsResource will return a new auth0_trigger_actions resource.
 func NewTriggerActionsResource() *schema.Resource {
-	return &schema.Resource{
+	return internalSchema.WithIDIdentity(&schema.Resource{
 		CreateContext: createTriggerBinding,
 		ReadContext:   readTriggerBinding,
 		UpdateContext: updateTriggerBinding,
@@ -68,7 +69,7 @@ func NewTriggerActionsResource() *schema.Resource {
 				Description: "The list of actions bound to this trigger.",
 			},
 		},
-	}
+	})
 }
 
 func createTriggerBinding(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -16,11 +16,12 @@ import (
 
 	"github.com/auth0/terraform-provider-auth0/internal/config"
 	apierr "github.com/auth0/terraform-provider-auth0/internal/error"
+	internalSchema "github.com/auth0/terraform-provider-auth0/internal/schema"
 )
 
 // NewResource will return a new auth0_attack_protection resource.
 func NewResource() *schema.Resource {
-	return &schema.Resource{
+	return internalSchema.WithIDIdentity(&schema.Resource{
 		CreateContext: createAttackProtection,
 		ReadContext:   readAttackProtection,
 		UpdateContext: updateAttackProtection,
@@ -557,7 +558,7 @@ func NewResource() *schema.Resource {
 				},
 			},
 		},
-	}
+	})
 }
 
 // validateCaptchaProviderSecrets returns a CustomizeDiffFunc that validates
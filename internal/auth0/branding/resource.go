@@ -13,6 +13,7 @@ import (
 
 	"github.com/auth0/terraform-provider-auth0/internal/config"
 	internalError "github.com/auth0/terraform-provider-auth0/internal/error"
+	internalSchema "github.com/auth0/terraform-provider-auth0/internal/schema"
 	internalValidation "github.com/auth0/terraform-provider-auth0/internal/validation"
 )
 
@@ -23,7 +24,7 @@ var errNoCustomDomain = fmt.Errorf(
 
 // NewResource will return a new auth0_branding resource.
 func NewResource() *schema.Resource {
-	return &schema.Resource{
+	return internalSchema.WithIDIdentity(&schema.Resource{
 		CreateContext: createBranding,
 		ReadContext:   readBranding,
 		UpdateContext: updateBranding,
@@ -102,7 +103,7 @@ func NewResource() *schema.Resource {
 				},
 			},
 		},
-	}
+	})
 }
 
 func createBranding(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -9,13 +9,14 @@ import (
 
 	"github.com/auth0/terraform-provider-auth0/internal/config"
 	internalError "github.com/auth0/terraform-provider-auth0/internal/error"
+	internalSchema "github.com/auth0/terraform-provider-auth0/internal/schema"
 )
 
 var supportedDeliveryMethods = []string{"voice", "text"}
 
 // NewPhoneProviderResource will return a new auth0_phone_provider resource.
 func NewPhoneProviderResource() *schema.Resource {
-	return &schema.Resource{
+	return internalSchema.WithIDIdentity(&schema.Resource{
 		CreateContext: createPhoneProvider,
 		ReadContext:   readPhoneProvider,
 		UpdateContext: updatePhoneProvider,
@@ -112,7 +113,7 @@ func NewPhoneProviderResource() *schema.Resource {
 				},
 			},
 		},
-	}
+	})
 }
 
 func createPhoneProvider(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -9,11 +9,12 @@ import (
 
 	"github.com/auth0/terraform-provider-auth0/internal/config"
 	internalError "github.com/auth0/terraform-provider-auth0/internal/error"
+	internalSchema "github.com/auth0/terraform-provider-auth0/internal/schema"
 )
 
 // NewPhoneNotificationTemplateResource returns a new auth0_branding_phone_notification_template resource.
 func NewPhoneNotificationTemplateResource() *schema.Resource {
-	return &schema.Resource{
+	return internalSchema.WithIDIdentity(&schema.Resource{
 		CreateContext: createPhoneNotificationTemplate,
 		ReadContext:   readPhoneNotificationTemplate,
 		UpdateContext: updatePhoneNotificationTemplate,
@@ -107,7 +108,7 @@ func NewPhoneNotificationTemplateResource() *schema.Resource {
 				},
 			},
 		},
-	}
+	})
 }
 
 func createPhoneNotificationTemplate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
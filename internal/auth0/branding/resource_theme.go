@@ -9,11 +9,12 @@ import (
 
 	"github.com/auth0/terraform-provider-auth0/internal/config"
 	internalError "github.com/auth0/terraform-provider-auth0/internal/error"
+	internalSchema "github.com/auth0/terraform-provider-auth0/internal/schema"
 )
 
 // NewThemeResource will return a new auth0_branding_theme resource.
 func NewThemeResource() *schema.Resource {
-	return &schema.Resource{
+	return internalSchema.WithIDIdentity(&schema.Resource{
 		Description: "This resource allows you to manage branding themes for your Universal Login page " +
 			"within your Auth0 tenant.",
 		CreateContext: createBrandingTheme,
@@ -507,7 +508,7 @@ func NewThemeResource() *schema.Resource {
 				},
 			},
 		},
-	}
+	})
 }
 
 func createBrandingTheme(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
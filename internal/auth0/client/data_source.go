@@ -311,6 +311,31 @@ func readClientForDataSource(ctx context.Context, data *schema.ResourceData, met
 		return diag.Errorf("One of 'client_id' or 'name' is required.")
 	}
 
+	clients, err := findClientsByName(ctx, api, name, 1)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if len(clients) == 0 {
+		return diag.Errorf("No client found with \"name\" = %q", name)
+	}
+
+	data.SetId(clients[0].GetClientID())
+	err = flattenClientForDataSource(ctx, api, data, clients[0])
+
+	return diag.FromErr(err)
+}
+
+// findClientsByName returns the clients with the given name, stopping
+// once limit clients are found. A limit of 0 returns all the clients.
+func findClientsByName(
+	ctx context.Context,
+	api *management.Management,
+	name string,
+	limit int,
+) ([]*management.Client, error) {
+	var found []*management.Client
+
 	var page int
 	for {
 		clients, err := api.Client.List(
@@ -319,23 +344,22 @@ func readClientForDataSource(ctx context.Context, data *schema.ResourceData, met
 			management.PerPage(100),
 		)
 		if err != nil {
-			return diag.FromErr(err)
+			return nil, err
 		}
 
 		for _, client := range clients.Clients {
 			if client.GetName() == name {
-				data.SetId(client.GetClientID())
-				err = flattenClientForDataSource(ctx, api, data, client)
-				return diag.FromErr(err)
+				found = append(found, client)
+				if len(found) == limit {
+					return found, nil
+				}
 			}
 		}
 
 		if !clients.HasNext() {
-			break
+			return found, nil
 		}
 
 		page++
 	}
-
-	return diag.Errorf("No client found with \"name\" = %q", name)
 }
@@ -0,0 +1,194 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/auth0/terraform-provider-auth0/internal/config"
+)
+
+var (
+	_ ephemeral.EphemeralResourceWithConfigure      = &credentialsTokenEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithValidateConfig = &credentialsTokenEphemeralResource{}
+)
+
+// NewCredentialsTokenEphemeralResource will return a new auth0_client_credentials_token ephemeral resource.
+func NewCredentialsTokenEphemeralResource() ephemeral.EphemeralResource {
+	return &credentialsTokenEphemeralResource{}
+}
+
+type credentialsTokenEphemeralResource struct {
+	config *config.Config
+}
+
+type credentialsTokenModel struct {
+	ClientID                  types.String `tfsdk:"client_id"`
+	ClientSecret              types.String `tfsdk:"client_secret"`
+	ClientAssertionPrivateKey types.String `tfsdk:"client_assertion_private_key"`
+	ClientAssertionSigningAlg types.String `tfsdk:"client_assertion_signing_alg"`
+	Audience                  types.String `tfsdk:"audience"`
+	AccessToken               types.String `tfsdk:"access_token"`
+	ExpiresAt                 types.String `tfsdk:"expires_at"`
+}
+
+func (r *credentialsTokenEphemeralResource) Metadata(
+	_ context.Context,
+	req ephemeral.MetadataRequest,
+	resp *ephemeral.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_client_credentials_token"
+}
+
+func (r *credentialsTokenEphemeralResource) Schema(
+	_ context.Context,
+	_ ephemeral.SchemaRequest,
+	resp *ephemeral.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "With this ephemeral resource, you can get an access token for an API using the " +
+			"client credentials grant of a client, e.g. to configure other providers. " +
+			"The access token is never stored in the plan or state.",
+		Attributes: map[string]schema.Attribute{
+			"client_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The ID of the client to get the access token for.",
+			},
+			"client_secret": schema.StringAttribute{
+				Optional:  true,
+				Sensitive: true,
+				MarkdownDescription: "The secret of the client. " +
+					"Conflicts with `client_assertion_private_key`.",
+			},
+			"client_assertion_private_key": schema.StringAttribute{
+				Optional:  true,
+				Sensitive: true,
+				MarkdownDescription: "The private key used to sign the client assertion JWT, when the client " +
+					"authenticates with `private_key_jwt`. Requires `client_assertion_signing_alg`.",
+			},
+			"client_assertion_signing_alg": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The algorithm used to sign the client assertion JWT, e.g. `RS256`.",
+			},
+			"audience": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The identifier of the API to get the access token for.",
+			},
+			"access_token": schema.StringAttribute{
+				Computed:            true,
+				Sensitive:           true,
+				MarkdownDescription: "The access token.",
+			},
+			"expires_at": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The time at which the access token expires, in RFC 3339 format.",
+			},
+		},
+	}
+}
+
+func (r *credentialsTokenEphemeralResource) Configure(
+	_ context.Context,
+	req ephemeral.ConfigureRequest,
+	resp *ephemeral.ConfigureResponse,
+) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerConfig, ok := req.ProviderData.(*config.Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider Data",
+			fmt.Sprintf("Expected *config.Config, got: %T.", req.ProviderData),
+		)
+		return
+	}
+
+	r.config = providerConfig
+}
+
+func (r *credentialsTokenEphemeralResource) ValidateConfig(
+	ctx context.Context,
+	req ephemeral.ValidateConfigRequest,
+	resp *ephemeral.ValidateConfigResponse,
+) {
+	var data credentialsTokenModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Unknown values get validated once they are known.
+	if data.ClientSecret.IsUnknown() ||
+		data.ClientAssertionPrivateKey.IsUnknown() ||
+		data.ClientAssertionSigningAlg.IsUnknown() {
+		return
+	}
+
+	hasSecret := !data.ClientSecret.IsNull()
+	hasPrivateKey := !data.ClientAssertionPrivateKey.IsNull()
+	hasSigningAlg := !data.ClientAssertionSigningAlg.IsNull()
+
+	switch {
+	case hasSecret && hasPrivateKey:
+		resp.Diagnostics.AddAttributeError(
+			path.Root("client_secret"),
+			"Conflicting Client Credentials",
+			"Only one of client_secret or client_assertion_private_key can be configured.",
+		)
+	case !hasSecret && !hasPrivateKey:
+		resp.Diagnostics.AddError(
+			"Missing Client Credentials",
+			"Either client_secret or client_assertion_private_key must be configured.",
+		)
+	case hasPrivateKey != hasSigningAlg:
+		resp.Diagnostics.AddAttributeError(
+			path.Root("client_assertion_signing_alg"),
+			"Invalid Client Assertion",
+			"The client_assertion_private_key and client_assertion_signing_alg must be configured together.",
+		)
+	}
+}
+
+func (r *credentialsTokenEphemeralResource) Open(
+	ctx context.Context,
+	req ephemeral.OpenRequest,
+	resp *ephemeral.OpenResponse,
+) {
+	if r.config == nil {
+		resp.Diagnostics.AddError(
+			"Unconfigured Provider",
+			"The provider must be configured before getting an access token.",
+		)
+		return
+	}
+
+	var data credentialsTokenModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	accessToken, expiresAt, err := r.config.ClientCredentialsToken(ctx, config.ClientCredentials{
+		ClientID:                  data.ClientID.ValueString(),
+		ClientSecret:              data.ClientSecret.ValueString(),
+		ClientAssertionPrivateKey: data.ClientAssertionPrivateKey.ValueString(),
+		ClientAssertionSigningAlg: data.ClientAssertionSigningAlg.ValueString(),
+		Audience:                  data.Audience.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to Get an Access Token", err.Error())
+		return
+	}
+
+	data.AccessToken = types.StringValue(accessToken)
+	data.ExpiresAt = types.StringValue(expiresAt.UTC().Format(time.RFC3339))
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
@@ -481,11 +481,13 @@ func expandClientMobileIOS(iosConfig cty.Value) *management.ClientMobileIOS {
 }
 
 func expandClientMetadata(data *schema.ResourceData) *map[string]interface{} {
-	if !data.HasChange("client_metadata") {
+	// The client_metadata_all attribute holds the client_metadata
+	// merged with the default metadata of the provider.
+	if !data.HasChange("client_metadata_all") {
 		return nil
 	}
 
-	oldMetadata, newMetadata := data.GetChange("client_metadata")
+	oldMetadata, newMetadata := data.GetChange("client_metadata_all")
 	oldMetadataMap := oldMetadata.(map[string]interface{})
 	newMetadataMap := newMetadata.(map[string]interface{})
 
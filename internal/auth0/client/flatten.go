@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"github.com/auth0/terraform-provider-auth0/internal/auth0/commons"
+	"github.com/auth0/terraform-provider-auth0/internal/config"
 	"github.com/auth0/terraform-provider-auth0/internal/value"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
@@ -661,7 +662,7 @@ func flattenTokenExchange(tokenExchange *management.ClientTokenExchange) []inter
 	return []interface{}{t}
 }
 
-func flattenClient(data *schema.ResourceData, client *management.Client) error {
+func flattenClient(data *schema.ResourceData, client *management.Client, defaultMetadata config.DefaultMetadata) error {
 	result := multierror.Append(
 		data.Set("client_id", client.GetClientID()),
 		data.Set("client_aliases", client.GetClientAliases()),
@@ -696,7 +697,12 @@ func flattenClient(data *schema.ResourceData, client *management.Client) error {
 		data.Set("mobile", flattenClientMobile(client.GetMobile())),
 		data.Set("initiate_login_uri", client.GetInitiateLoginURI()),
 		data.Set("signing_keys", client.SigningKeys),
-		data.Set("client_metadata", client.GetClientMetadata()),
+		data.Set("client_metadata", config.SuppressDefaultMetadata(
+			defaultMetadata,
+			client.GetClientMetadata(),
+			data.Get("client_metadata").(map[string]interface{}),
+		)),
+		data.Set("client_metadata_all", client.GetClientMetadata()),
 		data.Set("oidc_backchannel_logout_urls", flattenOIDCBackchannelURLs(client.GetOIDCBackchannelLogout(), client.GetOIDCLogout())),
 		data.Set("oidc_logout", flattenOIDCLogout(client.GetOIDCLogout())),
 		data.Set("require_pushed_authorization_requests", client.GetRequirePushedAuthorizationRequests()),
@@ -828,7 +834,7 @@ func flattenClientForDataSource(ctx context.Context, api *management.Management,
 	}
 
 	result := multierror.Append(
-		flattenClient(data, client),
+		flattenClient(data, client, nil),
 		data.Set("client_secret", clientSecret),
 		data.Set("token_endpoint_auth_method", client.GetTokenEndpointAuthMethod()),
 	)
@@ -0,0 +1,27 @@
+package client
+
+import (
+	"context"
+
+	"github.com/auth0/terraform-provider-auth0/internal/config"
+	internalSchema "github.com/auth0/terraform-provider-auth0/internal/schema"
+)
+
+// ImportIDResolvers resolve the human-readable import IDs of clients, e.g. "name:My App".
+var ImportIDResolvers = internalSchema.ImportIDResolvers{
+	"name": resolveClientIDByName,
+}
+
+func resolveClientIDByName(ctx context.Context, meta interface{}, name string) (string, error) {
+	clients, err := findClientsByName(ctx, meta.(*config.Config).GetAPI(), name, 0)
+	if err != nil {
+		return "", err
+	}
+
+	ids := make([]string, 0, len(clients))
+	for _, client := range clients {
+		ids = append(ids, client.GetClientID())
+	}
+
+	return internalSchema.UniqueImportID("client", "name", name, ids)
+}
@@ -0,0 +1,121 @@
+package client
+
+import (
+	"context"
+	"strings"
+
+	"github.com/auth0/go-auth0/management"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/auth0/terraform-provider-auth0/internal/config"
+	internalSchema "github.com/auth0/terraform-provider-auth0/internal/schema"
+)
+
+// NewListResource will return a new auth0_client list resource.
+func NewListResource() list.ListResource {
+	return internalSchema.NewListResource(internalSchema.ListResourceConfig{
+		TypeName: "_client",
+		Resource: NewResource,
+		Schema: schema.Schema{
+			MarkdownDescription: "With this list resource, you can find the clients (applications) of the tenant, " +
+				"e.g. to import them with `terraform query`. CIMD clients are managed by `auth0_client_cimd` and are not listed.",
+			Attributes: map[string]schema.Attribute{
+				"name_filter": schema.StringAttribute{
+					Optional:            true,
+					MarkdownDescription: "Filter clients by name (partial matches supported).",
+				},
+				"app_types": schema.ListAttribute{
+					ElementType:         types.StringType,
+					Optional:            true,
+					MarkdownDescription: "Filter clients by application types.",
+				},
+				"is_first_party": schema.BoolAttribute{
+					Optional:            true,
+					MarkdownDescription: "Filter clients by first party status.",
+				},
+				"external_client_id": schema.StringAttribute{
+					Optional:            true,
+					MarkdownDescription: "Filter clients by CIMD external client ID URL.",
+				},
+			},
+		},
+		List: listClients,
+	})
+}
+
+type listClientsModel struct {
+	NameFilter       types.String `tfsdk:"name_filter"`
+	AppTypes         types.List   `tfsdk:"app_types"`
+	IsFirstParty     types.Bool   `tfsdk:"is_first_party"`
+	ExternalClientID types.String `tfsdk:"external_client_id"`
+}
+
+func listClients(
+	ctx context.Context,
+	filters tfsdk.Config,
+	meta interface{},
+	yield func(internalSchema.ListItem) bool,
+) diag.Diagnostics {
+	api := meta.(*config.Config).GetAPI()
+
+	var data listClientsModel
+	diagnostics := filters.Get(ctx, &data)
+	if diagnostics.HasError() {
+		return diagnostics
+	}
+
+	var appTypes []string
+	diagnostics.Append(data.AppTypes.ElementsAs(ctx, &appTypes, false)...)
+	if diagnostics.HasError() {
+		return diagnostics
+	}
+
+	params := []management.RequestOption{
+		management.PerPage(100),
+	}
+
+	if len(appTypes) > 0 {
+		params = append(params, management.Parameter("app_type", strings.Join(appTypes, ",")))
+	}
+	if data.IsFirstParty.ValueBool() {
+		params = append(params, management.Parameter("is_first_party", "true"))
+	}
+	if externalClientID := data.ExternalClientID.ValueString(); externalClientID != "" {
+		params = append(params, management.Parameter("external_client_id", externalClientID))
+	}
+
+	nameFilter := data.NameFilter.ValueString()
+
+	var page int
+	for {
+		clients, err := api.Client.List(ctx, append(params, management.Page(page))...)
+		if err != nil {
+			diagnostics.AddError("Failed to List Clients", err.Error())
+			return diagnostics
+		}
+
+		for _, client := range clients.Clients {
+			if client.GetExternalMetadataType() == "cimd" {
+				continue
+			}
+
+			if nameFilter != "" && !strings.Contains(client.GetName(), nameFilter) {
+				continue
+			}
+
+			if !yield(internalSchema.ListItem{ID: client.GetClientID(), DisplayName: client.GetName()}) {
+				return diagnostics
+			}
+		}
+
+		if !clients.HasNext() {
+			return diagnostics
+		}
+
+		page++
+	}
+}
@@ -13,11 +13,12 @@ import (
 	"github.com/auth0/terraform-provider-auth0/internal/auth0/commons"
 	"github.com/auth0/terraform-provider-auth0/internal/config"
 	internalError "github.com/auth0/terraform-provider-auth0/internal/error"
+	internalSchema "github.com/auth0/terraform-provider-auth0/internal/schema"
 )
 
 // NewCIMDResource returns a new auth0_client_cimd resource.
 func NewCIMDResource() *schema.Resource {
-	return &schema.Resource{
+	return internalSchema.WithIDIdentity(&schema.Resource{
 		CreateContext: createCIMDClient,
 		ReadContext:   readCIMDClient,
 		UpdateContext: updateCIMDClient,
@@ -31,7 +32,7 @@ func NewCIMDResource() *schema.Resource {
 			"metadata document instead of using Dynamic Client Registration.\n\n" +
 			"Requires the `client_id_metadata_document_supported` tenant setting to be enabled.",
 		Schema: cimdClientSchema(),
-	}
+	})
 }
 
 func cimdClientSchema() map[string]*schema.Schema {
@@ -7,6 +7,8 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	internalSchema "github.com/auth0/terraform-provider-auth0/internal/schema"
 )
 
 func privateKeyJWTCredentialSetHash(v interface{}) int {
@@ -104,7 +106,7 @@ func validateTokenVaultPrivilegedAccess(_ context.Context, diff *schema.Resource
 
 // NewCredentialsResource will return a new auth0_client_credentials resource.
 func NewCredentialsResource() *schema.Resource {
-	return &schema.Resource{
+	return internalSchema.WithIDIdentity(&schema.Resource{
 		CustomizeDiff: validateTokenVaultPrivilegedAccess,
 		Schema: map[string]*schema.Schema{
 			"client_id": {
@@ -652,7 +654,7 @@ func NewCredentialsResource() *schema.Resource {
 		},
 		Description: "With this resource, you can configure the method to use when making requests to any endpoint " +
 			"that requires this client to authenticate.",
-	}
+	})
 }
 
 // credentialsResourceV0 returns the V0 schema (before TypeList->TypeSet migration).
@@ -13,11 +13,12 @@ import (
 
 	"github.com/auth0/terraform-provider-auth0/internal/config"
 	internalError "github.com/auth0/terraform-provider-auth0/internal/error"
+	internalSchema "github.com/auth0/terraform-provider-auth0/internal/schema"
 )
 
 // NewGrantResource will return a new auth0_client_grant resource.
 func NewGrantResource() *schema.Resource {
-	return &schema.Resource{
+	return internalSchema.WithIDIdentity(&schema.Resource{
 		CreateContext: createClientGrant,
 		ReadContext:   readClientGrant,
 		UpdateContext: updateClientGrant,
@@ -110,7 +111,7 @@ func NewGrantResource() *schema.Resource {
 					"`scopes` can not be provided when this is set to `true`. EA Only.",
 			},
 		},
-	}
+	})
 }
 
 func createClientGrant(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -79,6 +79,40 @@ func readConnectionForDataSource(ctx context.Context, data *schema.ResourceData,
 	}
 
 	name := data.Get("name").(string)
+
+	connections, err := findConnectionsByName(ctx, api, name, 1)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if len(connections) == 0 {
+		return diag.Errorf("No connection found with \"name\" = %q", name)
+	}
+
+	connection := connections[0]
+	data.SetId(connection.GetID())
+
+	var existingClients *management.ConnectionEnabledClientList
+	skipEnabledClients := data.Get("skip_enabled_clients").(bool)
+	if !skipEnabledClients {
+		existingClients, err = GetAllEnabledClients(ctx, api, connection.GetID())
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return flattenConnectionForDataSource(data, connection, existingClients)
+}
+
+// findConnectionsByName returns the connections with the given name, stopping
+// once limit connections are found. A limit of 0 returns all the connections.
+func findConnectionsByName(
+	ctx context.Context,
+	api *management.Management,
+	name string,
+	limit int,
+) ([]*management.Connection, error) {
+	var found []*management.Connection
 	var from string
 
 	options := []management.RequestOption{
@@ -92,34 +126,24 @@ func readConnectionForDataSource(ctx context.Context, data *schema.ResourceData,
 
 		connections, err := api.Connection.List(ctx, options...)
 		if err != nil {
-			return diag.FromErr(err)
+			return nil, err
 		}
 
 		for _, connection := range connections.Connections {
 			if connection.GetName() == name {
-				data.SetId(connection.GetID())
-
-				var existingClients *management.ConnectionEnabledClientList
-				skipEnabledClients := data.Get("skip_enabled_clients").(bool)
-				if !skipEnabledClients {
-					existingClients, err = GetAllEnabledClients(ctx, api, connection.GetID())
-					if err != nil {
-						return diag.FromErr(err)
-					}
+				found = append(found, connection)
+				if len(found) == limit {
+					return found, nil
 				}
-
-				return flattenConnectionForDataSource(data, connection, existingClients)
 			}
 		}
 
 		if !connections.HasNext() {
-			break
+			return found, nil
 		}
 
 		from = connections.Next
 	}
-
-	return diag.Errorf("No connection found with \"name\" = %q", name)
 }
 
 // GetAllEnabledClients fetches all enabled clients for a given connectionID
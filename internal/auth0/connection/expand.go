@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
+	"github.com/auth0/terraform-provider-auth0/internal/config"
 	"github.com/auth0/terraform-provider-auth0/internal/value"
 )
 
@@ -69,13 +70,14 @@ func expandConnection(
 	ctx context.Context,
 	data *schema.ResourceData,
 	api *management.Management,
+	defaultMetadata config.DefaultMetadata,
 ) (*management.Connection, diag.Diagnostics) {
 	config := data.GetRawConfig()
 
 	connection := &management.Connection{
 		DisplayName:                 value.String(config.GetAttr("display_name")),
 		IsDomainConnection:          value.Bool(config.GetAttr("is_domain_connection")),
-		Metadata:                    value.MapOfStrings(config.GetAttr("metadata")),
+		Metadata:                    defaultMetadata.Merge(value.MapOfStrings(config.GetAttr("metadata"))),
 		Authentication:              expandConnectionAuthentication(data),
 		ConnectedAccounts:           expandConnectionConnectedAccounts(data),
 		CrossAppAccessRequestingApp: expandConnectionCrossAppAccessRequestingApp(data),
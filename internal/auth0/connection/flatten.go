@@ -11,6 +11,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+
+	"github.com/auth0/terraform-provider-auth0/internal/config"
 )
 
 var errUnsupportedConnectionOptionsType = errors.New("unsupported connection options type")
@@ -61,7 +63,11 @@ var flattenConnectionOptionsMap = map[string]flattenConnectionOptionsFunc{
 
 type flattenConnectionOptionsFunc func(data *schema.ResourceData, options interface{}) (interface{}, diag.Diagnostics)
 
-func flattenConnection(data *schema.ResourceData, connection *management.Connection) diag.Diagnostics {
+func flattenConnection(
+	data *schema.ResourceData,
+	connection *management.Connection,
+	defaultMetadata config.DefaultMetadata,
+) diag.Diagnostics {
 	connectionOptions, diags := flattenConnectionOptions(data, connection)
 	if diags.HasError() {
 		return diags
@@ -74,7 +80,12 @@ func flattenConnection(data *schema.ResourceData, connection *management.Connect
 		data.Set("strategy", connection.GetStrategy()),
 		data.Set("options", connectionOptions),
 		data.Set("realms", connection.GetRealms()),
-		data.Set("metadata", connection.GetMetadata()),
+		data.Set("metadata", config.SuppressDefaultMetadata(
+			defaultMetadata,
+			connection.GetMetadata(),
+			data.Get("metadata").(map[string]interface{}),
+		)),
+		data.Set("metadata_all", connection.GetMetadata()),
 		data.Set("authentication", flattenConnectionAuthentication(connection.GetAuthentication())),
 		data.Set("connected_accounts", flattenConnectionConnectedAccounts(connection.GetConnectedAccounts())),
 		data.Set("cross_app_access_requesting_app", flattenConnectionCrossAppAccessRequestingApp(connection.GetCrossAppAccessRequestingApp())),
@@ -89,7 +100,7 @@ func flattenConnection(data *schema.ResourceData, connection *management.Connect
 }
 
 func flattenConnectionForDataSource(data *schema.ResourceData, connection *management.Connection, enabledClients *management.ConnectionEnabledClientList) diag.Diagnostics {
-	diags := flattenConnection(data, connection)
+	diags := flattenConnection(data, connection, nil)
 
 	var clientIDs []string
 	for _, ec := range enabledClients.GetClients() {
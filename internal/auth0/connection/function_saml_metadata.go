@@ -0,0 +1,244 @@
+package connection
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"encoding/xml"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const (
+	samlHTTPRedirectBinding = "urn:oasis:names:tc:SAML:2.0:bindings:HTTP-Redirect"
+	samlHTTPPostBinding     = "urn:oasis:names:tc:SAML:2.0:bindings:HTTP-POST"
+	samlProtocol            = "urn:oasis:names:tc:SAML:2.0:protocol"
+)
+
+var _ function.Function = &samlMetadataFunction{}
+
+// NewSAMLMetadataFunction will return a new saml_metadata provider function.
+func NewSAMLMetadataFunction() function.Function {
+	return &samlMetadataFunction{}
+}
+
+type samlMetadataFunction struct{}
+
+// samlMetadataOptions holds the options of a SAML connection found in the metadata
+// of the IdP, named after the attributes of the `options` block of `auth0_connection`.
+type samlMetadataOptions struct {
+	EntityID        types.String `tfsdk:"entity_id"`
+	SignInEndpoint  types.String `tfsdk:"sign_in_endpoint"`
+	SignOutEndpoint types.String `tfsdk:"sign_out_endpoint"`
+	ProtocolBinding types.String `tfsdk:"protocol_binding"`
+	SigningCert     types.String `tfsdk:"signing_cert"`
+	// SigningCerts holds every signing certificate with its validity period, so
+	// that the caller can pick one during a certificate rotation, as a function
+	// can't depend on the current time.
+	SigningCerts []samlSigningCert `tfsdk:"signing_certs"`
+}
+
+// samlSigningCert is a signing certificate of the metadata, PEM encoded,
+// with its validity period in RFC 3339 format.
+type samlSigningCert struct {
+	Cert      types.String `tfsdk:"cert"`
+	NotBefore types.String `tfsdk:"not_before"`
+	NotAfter  types.String `tfsdk:"not_after"`
+}
+
+var samlSigningCertType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"cert":       types.StringType,
+		"not_before": types.StringType,
+		"not_after":  types.StringType,
+	},
+}
+
+func (f *samlMetadataFunction) Metadata(
+	_ context.Context,
+	_ function.MetadataRequest,
+	resp *function.MetadataResponse,
+) {
+	resp.Name = "saml_metadata"
+}
+
+func (f *samlMetadataFunction) Definition(
+	_ context.Context,
+	_ function.DefinitionRequest,
+	resp *function.DefinitionResponse,
+) {
+	resp.Definition = function.Definition{
+		Summary: "Parses the SAML metadata of an identity provider into SAML connection options.",
+		MarkdownDescription: "Parses the SAML metadata XML of an identity provider and returns an object whose " +
+			"`entity_id`, `sign_in_endpoint`, `sign_out_endpoint`, `protocol_binding` and `signing_cert` " +
+			"attributes can be used as is in the `options` block of a `samlp` `auth0_connection`. " +
+			"The `HTTP-Redirect` binding is preferred over the `HTTP-POST` one, and `sign_out_endpoint` " +
+			"is null when the identity provider doesn't support single logout. `signing_cert` is the first " +
+			"signing certificate of the metadata. During a certificate rotation, the metadata holds several " +
+			"of them, which `signing_certs` lists along with their `not_before` and `not_after` validity dates, " +
+			"e.g. to pick the one valid at `plantimestamp()`. " +
+			"It fails when the metadata is malformed or doesn't hold a valid signing certificate.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "xml",
+				MarkdownDescription: "The SAML metadata XML of the identity provider, holding an `EntityDescriptor` with an `IDPSSODescriptor`.",
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: map[string]attr.Type{
+				"entity_id":         types.StringType,
+				"sign_in_endpoint":  types.StringType,
+				"sign_out_endpoint": types.StringType,
+				"protocol_binding":  types.StringType,
+				"signing_cert":      types.StringType,
+				"signing_certs":     types.ListType{ElemType: samlSigningCertType},
+			},
+		},
+	}
+}
+
+func (f *samlMetadataFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var metadataXML string
+	resp.Error = req.Arguments.Get(ctx, &metadataXML)
+	if resp.Error != nil {
+		return
+	}
+
+	options, err := parseSAMLMetadata(metadataXML)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	resp.Error = resp.Result.Set(ctx, options)
+}
+
+type samlEntityDescriptor struct {
+	XMLName           xml.Name               `xml:"urn:oasis:names:tc:SAML:2.0:metadata EntityDescriptor"`
+	EntityID          string                 `xml:"entityID,attr"`
+	IDPSSODescriptors []samlIDPSSODescriptor `xml:"urn:oasis:names:tc:SAML:2.0:metadata IDPSSODescriptor"`
+}
+
+type samlIDPSSODescriptor struct {
+	ProtocolSupportEnumeration string              `xml:"protocolSupportEnumeration,attr"`
+	KeyDescriptors             []samlKeyDescriptor `xml:"urn:oasis:names:tc:SAML:2.0:metadata KeyDescriptor"`
+	SingleLogoutServices       []samlEndpoint      `xml:"urn:oasis:names:tc:SAML:2.0:metadata SingleLogoutService"`
+	SingleSignOnServices       []samlEndpoint      `xml:"urn:oasis:names:tc:SAML:2.0:metadata SingleSignOnService"`
+}
+
+type samlKeyDescriptor struct {
+	Use              string   `xml:"use,attr"`
+	X509Certificates []string `xml:"http://www.w3.org/2000/09/xmldsig# KeyInfo>X509Data>X509Certificate"`
+}
+
+type samlEndpoint struct {
+	Binding  string `xml:"Binding,attr"`
+	Location string `xml:"Location,attr"`
+}
+
+// parseSAMLMetadata returns the SAML connection options found in the metadata.
+func parseSAMLMetadata(metadataXML string) (*samlMetadataOptions, error) {
+	var entity samlEntityDescriptor
+	if err := xml.Unmarshal([]byte(metadataXML), &entity); err != nil {
+		return nil, fmt.Errorf("failed to parse the SAML metadata, expected an EntityDescriptor: %w", err)
+	}
+
+	if entity.EntityID == "" {
+		return nil, fmt.Errorf("the EntityDescriptor of the SAML metadata is missing its entityID")
+	}
+
+	var descriptor *samlIDPSSODescriptor
+	for i := range entity.IDPSSODescriptors {
+		if strings.Contains(entity.IDPSSODescriptors[i].ProtocolSupportEnumeration, samlProtocol) {
+			descriptor = &entity.IDPSSODescriptors[i]
+			break
+		}
+	}
+	if descriptor == nil {
+		return nil, fmt.Errorf("the SAML metadata of %q doesn't contain an IDPSSODescriptor supporting SAML 2.0", entity.EntityID)
+	}
+
+	signIn := preferredSAMLEndpoint(descriptor.SingleSignOnServices)
+	if signIn == nil {
+		return nil, fmt.Errorf("the IDPSSODescriptor of %q doesn't contain a SingleSignOnService "+
+			"with the HTTP-Redirect or HTTP-POST binding", entity.EntityID)
+	}
+
+	signingCerts, err := samlSigningCertificates(descriptor.KeyDescriptors)
+	if err != nil {
+		return nil, fmt.Errorf("invalid signing certificate in the SAML metadata of %q: %w", entity.EntityID, err)
+	}
+
+	options := &samlMetadataOptions{
+		EntityID:        types.StringValue(entity.EntityID),
+		SignInEndpoint:  types.StringValue(signIn.Location),
+		SignOutEndpoint: types.StringNull(),
+		ProtocolBinding: types.StringValue(signIn.Binding),
+		SigningCert:     signingCerts[0].Cert,
+		SigningCerts:    signingCerts,
+	}
+
+	if signOut := preferredSAMLEndpoint(descriptor.SingleLogoutServices); signOut != nil {
+		options.SignOutEndpoint = types.StringValue(signOut.Location)
+	}
+
+	return options, nil
+}
+
+// preferredSAMLEndpoint returns the endpoint with the HTTP-Redirect binding,
+// falling back to the one with the HTTP-POST binding, as these are the only
+// bindings supported by Auth0.
+func preferredSAMLEndpoint(endpoints []samlEndpoint) *samlEndpoint {
+	for _, binding := range []string{samlHTTPRedirectBinding, samlHTTPPostBinding} {
+		for i := range endpoints {
+			if endpoints[i].Binding == binding && endpoints[i].Location != "" {
+				return &endpoints[i]
+			}
+		}
+	}
+
+	return nil
+}
+
+// samlSigningCertificates returns the signing certificates, in the order of the metadata.
+// Metadata published during a certificate rotation holds both the old and the new certificates.
+func samlSigningCertificates(keyDescriptors []samlKeyDescriptor) ([]samlSigningCert, error) {
+	var signingCerts []samlSigningCert
+
+	for _, keyDescriptor := range keyDescriptors {
+		// Keys without a use are used for both signing and encryption.
+		if keyDescriptor.Use != "" && keyDescriptor.Use != "signing" {
+			continue
+		}
+
+		for _, encoded := range keyDescriptor.X509Certificates {
+			der, err := base64.StdEncoding.DecodeString(strings.Join(strings.Fields(encoded), ""))
+			if err != nil {
+				return nil, fmt.Errorf("the X509Certificate is not valid base64: %w", err)
+			}
+
+			certificate, err := x509.ParseCertificate(der)
+			if err != nil {
+				return nil, fmt.Errorf("failed to parse the X509Certificate: %w", err)
+			}
+
+			signingCerts = append(signingCerts, samlSigningCert{
+				Cert:      types.StringValue(string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}))),
+				NotBefore: types.StringValue(certificate.NotBefore.UTC().Format(time.RFC3339)),
+				NotAfter:  types.StringValue(certificate.NotAfter.UTC().Format(time.RFC3339)),
+			})
+		}
+	}
+
+	if len(signingCerts) == 0 {
+		return nil, fmt.Errorf("no KeyDescriptor with an X509Certificate used for signing was found")
+	}
+
+	return signingCerts, nil
+}
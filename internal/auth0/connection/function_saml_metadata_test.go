@@ -0,0 +1,162 @@
+package connection
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestSAMLCertificate(t *testing.T, notBefore, notAfter time.Time) []byte {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "idp.example.com"},
+		NotBefore:    notBefore,
+		NotAfter:     notAfter,
+	}
+	certificate, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+
+	return certificate
+}
+
+func testSAMLMetadata(keyDescriptors string) string {
+	return fmt.Sprintf(`<?xml version="1.0"?>
+<md:EntityDescriptor xmlns:md="urn:oasis:names:tc:SAML:2.0:metadata" xmlns:ds="http://www.w3.org/2000/09/xmldsig#" entityID="https://idp.example.com">
+  <md:IDPSSODescriptor protocolSupportEnumeration="urn:oasis:names:tc:SAML:2.0:protocol">
+    %s
+    <md:SingleLogoutService Binding="urn:oasis:names:tc:SAML:2.0:bindings:HTTP-POST" Location="https://idp.example.com/slo/post"/>
+    <md:SingleSignOnService Binding="urn:oasis:names:tc:SAML:2.0:bindings:HTTP-POST" Location="https://idp.example.com/sso/post"/>
+    <md:SingleSignOnService Binding="urn:oasis:names:tc:SAML:2.0:bindings:HTTP-Redirect" Location="https://idp.example.com/sso/redirect"/>
+  </md:IDPSSODescriptor>
+</md:EntityDescriptor>`, keyDescriptors)
+}
+
+func testSAMLKeyDescriptor(use string, certificate []byte) string {
+	return fmt.Sprintf(`<md:KeyDescriptor use=%q>
+      <ds:KeyInfo>
+        <ds:X509Data>
+          <ds:X509Certificate>
+            %s
+          </ds:X509Certificate>
+        </ds:X509Data>
+      </ds:KeyInfo>
+    </md:KeyDescriptor>`, use, base64.StdEncoding.EncodeToString(certificate))
+}
+
+func TestParseSAMLMetadata(t *testing.T) {
+	now := time.Now()
+	expired := newTestSAMLCertificate(t, now.Add(-48*time.Hour), now.Add(-24*time.Hour))
+	valid := newTestSAMLCertificate(t, now.Add(-time.Hour), now.Add(24*time.Hour))
+	encryption := newTestSAMLCertificate(t, now.Add(-time.Hour), now.Add(24*time.Hour))
+
+	t.Run("it returns the connection options and every signing certificate", func(t *testing.T) {
+		metadata := testSAMLMetadata(
+			testSAMLKeyDescriptor("encryption", encryption) +
+				testSAMLKeyDescriptor("signing", expired) +
+				testSAMLKeyDescriptor("signing", valid),
+		)
+
+		options, err := parseSAMLMetadata(metadata)
+		require.NoError(t, err)
+
+		expiredPEM := string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: expired}))
+		validPEM := string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: valid}))
+
+		assert.Equal(t, &samlMetadataOptions{
+			EntityID:        types.StringValue("https://idp.example.com"),
+			SignInEndpoint:  types.StringValue("https://idp.example.com/sso/redirect"),
+			SignOutEndpoint: types.StringValue("https://idp.example.com/slo/post"),
+			ProtocolBinding: types.StringValue("urn:oasis:names:tc:SAML:2.0:bindings:HTTP-Redirect"),
+			SigningCert:     types.StringValue(expiredPEM),
+			SigningCerts: []samlSigningCert{
+				{
+					Cert:      types.StringValue(expiredPEM),
+					NotBefore: types.StringValue(now.Add(-48 * time.Hour).UTC().Format(time.RFC3339)),
+					NotAfter:  types.StringValue(now.Add(-24 * time.Hour).UTC().Format(time.RFC3339)),
+				},
+				{
+					Cert:      types.StringValue(validPEM),
+					NotBefore: types.StringValue(now.Add(-time.Hour).UTC().Format(time.RFC3339)),
+					NotAfter:  types.StringValue(now.Add(24 * time.Hour).UTC().Format(time.RFC3339)),
+				},
+			},
+		}, options)
+	})
+
+	t.Run("it returns a null sign out endpoint without single logout", func(t *testing.T) {
+		metadata := `<EntityDescriptor xmlns="urn:oasis:names:tc:SAML:2.0:metadata"
+  xmlns:md="urn:oasis:names:tc:SAML:2.0:metadata" xmlns:ds="http://www.w3.org/2000/09/xmldsig#" entityID="https://idp.example.com">
+  <IDPSSODescriptor protocolSupportEnumeration="urn:oasis:names:tc:SAML:2.0:protocol">
+    ` + testSAMLKeyDescriptor("", valid) + `
+    <SingleSignOnService Binding="urn:oasis:names:tc:SAML:2.0:bindings:HTTP-POST" Location="https://idp.example.com/sso"/>
+  </IDPSSODescriptor>
+</EntityDescriptor>`
+
+		options, err := parseSAMLMetadata(metadata)
+		require.NoError(t, err)
+
+		assert.True(t, options.SignOutEndpoint.IsNull())
+		assert.Equal(t, "urn:oasis:names:tc:SAML:2.0:bindings:HTTP-POST", options.ProtocolBinding.ValueString())
+	})
+
+	var testCases = []struct {
+		name          string
+		metadata      string
+		expectedError string
+	}{
+		{
+			name:          "it fails on malformed XML",
+			metadata:      `<md:EntityDescriptor xmlns:md="urn:oasis:names:tc:SAML:2.0:metadata"`,
+			expectedError: "failed to parse the SAML metadata",
+		},
+		{
+			name:          "it fails on other documents",
+			metadata:      `<html><body>Not Found</body></html>`,
+			expectedError: "expected an EntityDescriptor",
+		},
+		{
+			name:          "it fails without an entity ID",
+			metadata:      `<EntityDescriptor xmlns="urn:oasis:names:tc:SAML:2.0:metadata"/>`,
+			expectedError: "missing its entityID",
+		},
+		{
+			name:          "it fails without an IdP descriptor",
+			metadata:      `<EntityDescriptor xmlns="urn:oasis:names:tc:SAML:2.0:metadata" entityID="https://sp.example.com"><SPSSODescriptor/></EntityDescriptor>`,
+			expectedError: `the SAML metadata of "https://sp.example.com" doesn't contain an IDPSSODescriptor`,
+		},
+		{
+			name:          "it fails without a signing certificate",
+			metadata:      testSAMLMetadata(testSAMLKeyDescriptor("encryption", encryption)),
+			expectedError: "no KeyDescriptor with an X509Certificate used for signing was found",
+		},
+		{
+			name:          "it fails on invalid certificates",
+			metadata:      testSAMLMetadata(testSAMLKeyDescriptor("signing", []byte("not a certificate"))),
+			expectedError: "failed to parse the X509Certificate",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			_, err := parseSAMLMetadata(testCase.metadata)
+			assert.ErrorContains(t, err, testCase.expectedError)
+		})
+	}
+}
@@ -0,0 +1,27 @@
+package connection
+
+import (
+	"context"
+
+	"github.com/auth0/terraform-provider-auth0/internal/config"
+	internalSchema "github.com/auth0/terraform-provider-auth0/internal/schema"
+)
+
+// ImportIDResolvers resolve the human-readable import IDs of connections, e.g. "name:Username-Password-Authentication".
+var ImportIDResolvers = internalSchema.ImportIDResolvers{
+	"name": resolveConnectionIDByName,
+}
+
+func resolveConnectionIDByName(ctx context.Context, meta interface{}, name string) (string, error) {
+	connections, err := findConnectionsByName(ctx, meta.(*config.Config).GetAPI(), name, 0)
+	if err != nil {
+		return "", err
+	}
+
+	ids := make([]string, 0, len(connections))
+	for _, connection := range connections {
+		ids = append(ids, connection.GetID())
+	}
+
+	return internalSchema.UniqueImportID("connection", "name", name, ids)
+}
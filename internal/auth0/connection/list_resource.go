@@ -0,0 +1,99 @@
+package connection
+
+import (
+	"context"
+	"strings"
+
+	"github.com/auth0/go-auth0/management"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/auth0/terraform-provider-auth0/internal/config"
+	internalSchema "github.com/auth0/terraform-provider-auth0/internal/schema"
+)
+
+// NewListResource will return a new auth0_connection list resource.
+func NewListResource() list.ListResource {
+	return internalSchema.NewListResource(internalSchema.ListResourceConfig{
+		TypeName: "_connection",
+		Resource: NewResource,
+		Schema: schema.Schema{
+			MarkdownDescription: "With this list resource, you can find the connections of the tenant, " +
+				"e.g. to import them with `terraform query`.",
+			Attributes: map[string]schema.Attribute{
+				"name_filter": schema.StringAttribute{
+					Optional:            true,
+					MarkdownDescription: "Filter connections by name (partial matches supported).",
+				},
+				"strategy": schema.StringAttribute{
+					Optional:            true,
+					MarkdownDescription: "Filter connections by strategy, e.g. `auth0` or `samlp`.",
+				},
+			},
+		},
+		List: listConnections,
+	})
+}
+
+type listConnectionsModel struct {
+	NameFilter types.String `tfsdk:"name_filter"`
+	Strategy   types.String `tfsdk:"strategy"`
+}
+
+func listConnections(
+	ctx context.Context,
+	filters tfsdk.Config,
+	meta interface{},
+	yield func(internalSchema.ListItem) bool,
+) diag.Diagnostics {
+	api := meta.(*config.Config).GetAPI()
+
+	var data listConnectionsModel
+	diagnostics := filters.Get(ctx, &data)
+	if diagnostics.HasError() {
+		return diagnostics
+	}
+
+	params := []management.RequestOption{
+		management.Take(100),
+	}
+
+	if strategy := data.Strategy.ValueString(); strategy != "" {
+		params = append(params, management.Parameter("strategy", strategy))
+	}
+
+	nameFilter := data.NameFilter.ValueString()
+
+	var from string
+	for {
+		options := params
+		if from != "" {
+			options = append(options, management.From(from))
+		}
+
+		connections, err := api.Connection.List(ctx, options...)
+		if err != nil {
+			diagnostics.AddError("Failed to List Connections", err.Error())
+			return diagnostics
+		}
+
+		for _, connection := range connections.Connections {
+			if nameFilter != "" && !strings.Contains(connection.GetName(), nameFilter) {
+				continue
+			}
+
+			if !yield(internalSchema.ListItem{ID: connection.GetID(), DisplayName: connection.GetName()}) {
+				return diagnostics
+			}
+		}
+
+		if !connections.HasNext() {
+			return diagnostics
+		}
+
+		from = connections.Next
+	}
+}
@@ -6,30 +6,35 @@ import (
 	"net/http"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/auth0/terraform-provider-auth0/internal/config"
 	internalError "github.com/auth0/terraform-provider-auth0/internal/error"
+	internalSchema "github.com/auth0/terraform-provider-auth0/internal/schema"
 )
 
 // NewResource will return a new auth0_connection resource.
 func NewResource() *schema.Resource {
-	return &schema.Resource{
+	return internalSchema.WithIDIdentity(&schema.Resource{
 		CreateContext: createConnection,
 		ReadContext:   readConnection,
 		UpdateContext: updateConnection,
 		DeleteContext: deleteConnection,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: internalSchema.ImportStateResolvingID(ImportIDResolvers, schema.ImportStatePassthroughContext),
 		},
-		CustomizeDiff: validateConnection,
+		CustomizeDiff: customdiff.All(
+			validateConnection,
+			config.PlanAllMetadata("metadata", "metadata_all"),
+		),
 		Description: "With Auth0, you can define sources of users, otherwise known as connections, " +
 			"which may include identity providers (such as Google or LinkedIn), databases, or " +
 			"passwordless authentication methods. This resource allows you to configure " +
 			"and manage connections to be used with your clients and users.",
 		Schema:        resourceSchema,
 		SchemaVersion: 3,
-	}
+	})
 }
 
 // validateConnection rejects a planned change to options.attributes.email.unique.
@@ -68,16 +73,38 @@ func validateConnection(_ context.Context, diff *schema.ResourceDiff, _ interfac
 	)
 }
 
+var apiErrorAttributePaths = internalError.AttributePaths{
+	Schema: resourceSchema,
+	Overrides: map[string]string{
+		"options.authorizationURL":  "options.0.authorization_endpoint",
+		"options.certAuth":          "options.0.use_cert_auth",
+		"options.disableSignout":    "options.0.disable_sign_out",
+		"options.idpinitiated":      "options.0.idp_initiated",
+		"options.kerberos":          "options.0.use_kerberos",
+		"options.signOuEndpoint":    "options.0.sign_out_endpoint",
+		"options.signSAMLRequest":   "options.0.sign_saml_request",
+		"options.tokenURL":          "options.0.token_endpoint",
+		"options.useCommonEndpoint": "options.0.waad_common_endpoint",
+		"options.userid_attribute":  "options.0.user_id_attribute",
+	},
+}
+
+// diagnosticsFromAPIError points the validation errors returned by the
+// Management API at the attributes of the connection that caused them.
+func diagnosticsFromAPIError(err error) diag.Diagnostics {
+	return internalError.APIErrorDiagnostics(err, apiErrorAttributePaths)
+}
+
 func createConnection(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	api := meta.(*config.Config).GetAPI()
 
-	connection, diagnostics := expandConnection(ctx, data, api)
+	connection, diagnostics := expandConnection(ctx, data, api, meta.(*config.Config).GetDefaultMetadata())
 	if diagnostics.HasError() {
 		return diagnostics
 	}
 
 	if err := api.Connection.Create(ctx, connection); err != nil {
-		return diag.FromErr(err)
+		return diagnosticsFromAPIError(err)
 	}
 
 	data.SetId(connection.GetID())
@@ -93,13 +120,13 @@ func readConnection(ctx context.Context, data *schema.ResourceData, meta interfa
 		return internalError.HandleReadAPIError("auth0_connection", data, err)
 	}
 
-	return flattenConnection(data, connection)
+	return flattenConnection(data, connection, meta.(*config.Config).GetDefaultMetadata())
 }
 
 func updateConnection(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	api := meta.(*config.Config).GetAPI()
 
-	connection, diagnostics := expandConnection(ctx, data, api)
+	connection, diagnostics := expandConnection(ctx, data, api, meta.(*config.Config).GetDefaultMetadata())
 	if diagnostics.HasError() {
 		return diagnostics
 	}
@@ -113,7 +140,7 @@ func updateConnection(ctx context.Context, data *schema.ResourceData, meta inter
 	}
 
 	if err := api.Connection.Update(ctx, data.Id(), connection); err != nil {
-		return diag.FromErr(internalError.HandleAPIError(data, err))
+		return diagnosticsFromAPIError(internalError.HandleAPIError(data, err))
 	}
 
 	return readConnection(ctx, data, meta)
@@ -9,6 +9,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
+	"github.com/auth0/terraform-provider-auth0/internal/auth0/client"
 	"github.com/auth0/terraform-provider-auth0/internal/config"
 	internalError "github.com/auth0/terraform-provider-auth0/internal/error"
 	internalSchema "github.com/auth0/terraform-provider-auth0/internal/schema"
@@ -16,7 +17,7 @@ import (
 
 // NewClientResource will return a new auth0_connection_client (1:1) resource.
 func NewClientResource() *schema.Resource {
-	return &schema.Resource{
+	return internalSchema.WithGroupIdentity(&schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"connection_id": {
 				Type:        schema.TypeString,
@@ -45,10 +46,17 @@ func NewClientResource() *schema.Resource {
 		ReadContext:   readConnectionClient,
 		DeleteContext: deleteConnectionClient,
 		Importer: &schema.ResourceImporter{
-			StateContext: internalSchema.ImportResourceGroupID("connection_id", "client_id"),
+			StateContext: internalSchema.ImportResourceGroupIDResolving(
+				map[string]internalSchema.ImportIDResolvers{
+					"connection_id": ImportIDResolvers,
+					"client_id":     client.ImportIDResolvers,
+				},
+				"connection_id",
+				"client_id",
+			),
 		},
 		Description: "With this resource, you can enable a single client on a connection.",
-	}
+	}, "connection_id", "client_id")
 }
 
 func createConnectionClient(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -58,8 +66,11 @@ func createConnectionClient(ctx context.Context, data *schema.ResourceData, meta
 	clientID := data.Get("client_id").(string)
 
 	mutex := meta.(*config.Config).GetMutex()
-	mutex.Lock(connectionID)
-	defer mutex.Unlock(connectionID)
+	ctx, err := mutex.Lock(ctx, connectionID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	defer mutex.Unlock(ctx, connectionID)
 
 	payload := []management.ConnectionEnabledClient{
 		{
@@ -118,8 +129,11 @@ func deleteConnectionClient(ctx context.Context, data *schema.ResourceData, meta
 	clientID := data.Get("client_id").(string)
 
 	mutex := meta.(*config.Config).GetMutex()
-	mutex.Lock(connectionID)
-	defer mutex.Unlock(connectionID)
+	ctx, err := mutex.Lock(ctx, connectionID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	defer mutex.Unlock(ctx, connectionID)
 
 	payload := []management.ConnectionEnabledClient{
 		{
@@ -13,12 +13,13 @@ import (
 
 	"github.com/auth0/terraform-provider-auth0/internal/config"
 	internalError "github.com/auth0/terraform-provider-auth0/internal/error"
+	internalSchema "github.com/auth0/terraform-provider-auth0/internal/schema"
 	"github.com/auth0/terraform-provider-auth0/internal/value"
 )
 
 // NewClientsResource will return a new auth0_connection_clients (1:many) resource.
 func NewClientsResource() *schema.Resource {
-	return &schema.Resource{
+	return internalSchema.WithIDIdentity(&schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"connection_id": {
 				Type:        schema.TypeString,
@@ -51,7 +52,7 @@ func NewClientsResource() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Description: "With this resource, you can manage all of the enabled clients on a connection.",
-	}
+	})
 }
 
 func createConnectionClients(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -10,6 +10,7 @@ import (
 
 	"github.com/auth0/terraform-provider-auth0/internal/config"
 	internalError "github.com/auth0/terraform-provider-auth0/internal/error"
+	internalSchema "github.com/auth0/terraform-provider-auth0/internal/schema"
 )
 
 var (
@@ -18,7 +19,7 @@ var (
 
 // NewDirectoryResource will return a new auth0_connection_directory (1:1) resource.
 func NewDirectoryResource() *schema.Resource {
-	return &schema.Resource{
+	return internalSchema.WithIDIdentity(&schema.Resource{
 		CreateContext: createDirectory,
 		UpdateContext: updateDirectory,
 		ReadContext:   readDirectory,
@@ -107,7 +108,7 @@ func NewDirectoryResource() *schema.Resource {
 				Description: "The error message of the last synchronization, if any.",
 			},
 		},
-	}
+	})
 }
 
 func createDirectory(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
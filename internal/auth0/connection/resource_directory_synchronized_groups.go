@@ -14,11 +14,12 @@ import (
 
 	"github.com/auth0/terraform-provider-auth0/internal/config"
 	internalError "github.com/auth0/terraform-provider-auth0/internal/error"
+	internalSchema "github.com/auth0/terraform-provider-auth0/internal/schema"
 )
 
 // NewDirectorySynchronizedGroupsResource will return a new auth0_connection_directory_synchronized_groups resource.
 func NewDirectorySynchronizedGroupsResource() *schema.Resource {
-	return &schema.Resource{
+	return internalSchema.WithIDIdentity(&schema.Resource{
 		CreateContext: createDirectorySynchronizedGroups,
 		ReadContext:   readDirectorySynchronizedGroups,
 		UpdateContext: updateDirectorySynchronizedGroups,
@@ -75,7 +76,7 @@ func NewDirectorySynchronizedGroupsResource() *schema.Resource {
 				},
 			},
 		},
-	}
+	})
 }
 
 // groupsWriteChunkSize is the most groups the add and remove endpoints accept in one call.
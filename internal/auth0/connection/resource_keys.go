@@ -15,7 +15,7 @@ import (
 
 // NewKeysResource will return a new auth0_connection_keys resource.
 func NewKeysResource() *schema.Resource {
-	return &schema.Resource{
+	return internalSchema.WithGroupIdentity(&schema.Resource{
 		CreateContext: rotateConnectionKeys,
 		ReadContext:   readConnectionKeys,
 		UpdateContext: rotateConnectionKeys,
@@ -107,7 +107,10 @@ func NewKeysResource() *schema.Resource {
 				Description: "The subject distinguished name (DN) of the certificate.",
 			},
 		},
-	}
+		ResourceBehavior: schema.ResourceBehavior{
+			MutableIdentity: true,
+		},
+	}, "connection_id", "kid")
 }
 
 func rotateConnectionKeys(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -9,11 +9,12 @@ import (
 
 	"github.com/auth0/terraform-provider-auth0/internal/config"
 	internalError "github.com/auth0/terraform-provider-auth0/internal/error"
+	internalSchema "github.com/auth0/terraform-provider-auth0/internal/schema"
 )
 
 // NewConnectionProfileResource will return a new auth0_connection_profile resource.
 func NewConnectionProfileResource() *schema.Resource {
-	return &schema.Resource{
+	return internalSchema.WithIDIdentity(&schema.Resource{
 		CreateContext: createConnectionProfile,
 		ReadContext:   readConnectionProfile,
 		UpdateContext: updateConnectionProfile,
@@ -96,7 +97,7 @@ func NewConnectionProfileResource() *schema.Resource {
 				},
 			},
 		},
-	}
+	})
 }
 
 func strategyOverrideSchema() *schema.Schema {
@@ -2,18 +2,27 @@ package connection
 
 import (
 	"context"
+	"fmt"
+	"time"
 
+	"github.com/auth0/go-auth0/management"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"github.com/auth0/terraform-provider-auth0/internal/config"
 	internalError "github.com/auth0/terraform-provider-auth0/internal/error"
+	internalSchema "github.com/auth0/terraform-provider-auth0/internal/schema"
+	"github.com/auth0/terraform-provider-auth0/internal/wait"
 )
 
+// scimConfigurationCreateTimeout is the default timeout of waiting
+// for a new SCIM configuration to be readable.
+const scimConfigurationCreateTimeout = 1 * time.Minute
+
 // NewSCIMConfigurationResource will return a new auth0_connection_scim_configuration (1:1) resource.
 func NewSCIMConfigurationResource() *schema.Resource {
-	return &schema.Resource{
+	return internalSchema.WithIDIdentity(&schema.Resource{
 		CreateContext: createSCIMConfiguration,
 		UpdateContext: updateSCIMConfiguration,
 		ReadContext:   readSCIMConfiguration,
@@ -21,6 +30,9 @@ func NewSCIMConfigurationResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(scimConfigurationCreateTimeout),
+		},
 		Description: "With this resource, you can configure [SCIM(System for Cross-domain Identity Management)](https://simplecloud.info/) support " +
 			"for `SAML` and `OpenID Connect` Enterprise connections.",
 		Schema: map[string]*schema.Schema{
@@ -78,7 +90,7 @@ func NewSCIMConfigurationResource() *schema.Resource {
 				},
 			},
 		},
-	}
+	})
 }
 
 func createSCIMConfiguration(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -92,7 +104,27 @@ func createSCIMConfiguration(ctx context.Context, data *schema.ResourceData, met
 
 	data.SetId(connectionID)
 
-	return readSCIMConfiguration(ctx, data, meta)
+	// The SCIM configuration can take a moment to be readable after its setup.
+	var createdSCIMConfiguration *management.SCIMConfiguration
+	err := wait.Until(ctx, wait.Options{
+		Operation: fmt.Sprintf("the SCIM configuration of connection %q to be set up", connectionID),
+		Timeout:   data.Timeout(schema.TimeoutCreate),
+	}, func(ctx context.Context) (bool, string, error) {
+		var err error
+		createdSCIMConfiguration, err = api.Connection.ReadSCIMConfiguration(ctx, connectionID)
+		if err != nil {
+			if internalError.IsStatusNotFound(err) {
+				return false, "not found", nil
+			}
+			return false, "", err
+		}
+		return true, "found", nil
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return flattenSCIMConfiguration(data, createdSCIMConfiguration)
 }
 
 func updateSCIMConfiguration(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
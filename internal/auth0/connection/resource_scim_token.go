@@ -7,6 +7,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
+	internalSchema "github.com/auth0/terraform-provider-auth0/internal/schema"
 	"github.com/auth0/terraform-provider-auth0/internal/value"
 
 	"github.com/auth0/go-auth0/management"
@@ -17,7 +18,7 @@ import (
 
 // NewSCIMTokenResource will return a new auth0_connection_scim_token resource.
 func NewSCIMTokenResource() *schema.Resource {
-	return &schema.Resource{
+	return internalSchema.WithIDIdentity(&schema.Resource{
 		CreateContext: createSCIMToken,
 		ReadContext:   readSCIMToken,
 		DeleteContext: deleteSCIMToken,
@@ -57,7 +58,7 @@ func NewSCIMTokenResource() *schema.Resource {
 				Description: "The date and time when the token was created (ISO8601 format).",
 			},
 		},
-	}
+	})
 }
 
 func createSCIMToken(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
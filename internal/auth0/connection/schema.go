@@ -62,6 +62,14 @@ var resourceSchema = map[string]*schema.Schema{
 		Description: "Metadata associated with the connection, in the form of a map of string values " +
 			"(max 255 chars).",
 	},
+	"metadata_all": {
+		Type: schema.TypeMap,
+		Elem: &schema.Schema{
+			Type: schema.TypeString,
+		},
+		Computed:    true,
+		Description: "All the metadata of the connection, including the `default_metadata` of the provider.",
+	},
 	"realms": {
 		Type: schema.TypeList,
 		Elem: &schema.Schema{
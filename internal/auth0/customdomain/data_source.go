@@ -21,7 +21,7 @@ func NewDataSource() *schema.Resource {
 }
 
 func dataSourceSchema() map[string]*schema.Schema {
-	dataSourceSchema := internalSchema.TransformResourceToDataSource(NewResource().Schema)
+	dataSourceSchema := customDomainDataSourceSchema()
 	dataSourceSchema["custom_domain_id"] = &schema.Schema{
 		Type:        schema.TypeString,
 		Description: "The ID of the Custom Domain.",
@@ -31,6 +31,15 @@ func dataSourceSchema() map[string]*schema.Schema {
 	return dataSourceSchema
 }
 
+// customDomainDataSourceSchema derives the attributes of a custom domain
+// in the data sources from the resource, without the ones only used on create.
+func customDomainDataSourceSchema() map[string]*schema.Schema {
+	customDomainSchema := internalSchema.TransformResourceToDataSource(NewResource().Schema)
+	delete(customDomainSchema, "adopt_existing")
+
+	return customDomainSchema
+}
+
 func readCustomDomainForDataSource(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	api := meta.(*config.Config).GetAPI()
 	customDomainID := data.Get("custom_domain_id").(string)
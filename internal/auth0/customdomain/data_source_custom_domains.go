@@ -9,7 +9,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/auth0/terraform-provider-auth0/internal/config"
-	internalSchema "github.com/auth0/terraform-provider-auth0/internal/schema"
 )
 
 // NewCustomDomainsDataSource returns a new auth0_custom_domains data source that allows
@@ -29,7 +28,7 @@ func NewCustomDomainsDataSource() *schema.Resource {
 				Computed:    true,
 				Description: "List of custom domains matching the search criteria.",
 				Elem: &schema.Resource{
-					Schema: internalSchema.TransformResourceToDataSource(NewResource().Schema),
+					Schema: customDomainDataSourceSchema(),
 				},
 			},
 		},
@@ -17,7 +17,8 @@ func expandCustomDomain(data *schema.ResourceData) *management.CustomDomain {
 		RelyingPartyIdentifier: value.String(cfg.GetAttr("relying_party_identifier")),
 	}
 
-	if data.IsNewResource() {
+	// Adopted existing custom domains already have an ID, and their domain and type can't be updated.
+	if data.IsNewResource() && data.Id() == "" {
 		customDomain.Domain = value.String(cfg.GetAttr("domain"))
 		customDomain.Type = value.String(cfg.GetAttr("type"))
 	}
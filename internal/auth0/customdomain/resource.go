@@ -2,19 +2,24 @@ package customdomain
 
 import (
 	"context"
+	"fmt"
 	"net/http"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
+	"github.com/auth0/go-auth0/management"
+
 	"github.com/auth0/terraform-provider-auth0/internal/config"
 	internalError "github.com/auth0/terraform-provider-auth0/internal/error"
+	internalSchema "github.com/auth0/terraform-provider-auth0/internal/schema"
 )
 
 // NewResource will return a new auth0_custom_domain resource.
 func NewResource() *schema.Resource {
-	return &schema.Resource{
+	return internalSchema.WithIDIdentity(&schema.Resource{
 		CreateContext: createCustomDomain,
 		ReadContext:   readCustomDomain,
 		UpdateContext: updateCustomDomain,
@@ -156,8 +161,9 @@ func NewResource() *schema.Resource {
 				Computed:    true,
 				Description: "Indicates whether this custom domain is the default domain for the tenant",
 			},
+			"adopt_existing": internalError.AdoptExistingSchema(),
 		},
-	}
+	})
 }
 
 func createCustomDomain(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -166,7 +172,26 @@ func createCustomDomain(ctx context.Context, data *schema.ResourceData, meta int
 	customDomain := expandCustomDomain(data)
 
 	if err := api.CustomDomain.Create(ctx, customDomain); err != nil {
-		return diag.FromErr(err)
+		domain := customDomain.GetDomain()
+		diagnostics, adopted := internalError.AdoptExisting(
+			ctx,
+			"auth0_custom_domain",
+			fmt.Sprintf("domain %q", domain),
+			data,
+			err,
+			internalError.AdoptExistingEnabled(data, meta.(*config.Config).GetAdoptExisting()),
+			func(ctx context.Context) (string, error) {
+				return findAdoptableCustomDomainID(ctx, api, domain, data.Get("type").(string))
+			},
+		)
+		if !adopted {
+			return diag.FromErr(err)
+		}
+		if diagnostics.HasError() {
+			return diagnostics
+		}
+
+		return append(diagnostics, updateCustomDomain(ctx, data, meta)...)
 	}
 
 	data.SetId(customDomain.GetID())
@@ -213,3 +238,38 @@ func deleteCustomDomain(ctx context.Context, data *schema.ResourceData, meta int
 
 	return nil
 }
+
+// findAdoptableCustomDomainID returns the ID of the custom domain with the
+// given domain, failing if its type differs from the configured one, as the
+// type of a custom domain can't be updated once it is created.
+func findAdoptableCustomDomainID(
+	ctx context.Context,
+	api *management.Management,
+	domain string,
+	customDomainType string,
+) (string, error) {
+	customDomains, err := api.CustomDomain.List(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	var ids []string
+	types := map[string]string{}
+	for _, customDomain := range customDomains {
+		if strings.EqualFold(customDomain.GetDomain(), domain) {
+			ids = append(ids, customDomain.GetID())
+			types[customDomain.GetID()] = customDomain.GetType()
+		}
+	}
+
+	id, err := internalSchema.UniqueImportID("custom domain", "domain", domain, ids)
+	if err != nil {
+		return "", err
+	}
+
+	if err := internalError.CheckAdoptableAttribute("type", types[id], customDomainType); err != nil {
+		return "", err
+	}
+
+	return id, nil
+}
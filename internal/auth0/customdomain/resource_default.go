@@ -9,11 +9,12 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/auth0/terraform-provider-auth0/internal/config"
+	internalSchema "github.com/auth0/terraform-provider-auth0/internal/schema"
 )
 
 // NewDefaultResource will return a new auth0_custom_domain_default resource.
 func NewDefaultResource() *schema.Resource {
-	return &schema.Resource{
+	return internalSchema.WithIDIdentity(&schema.Resource{
 		CreateContext: createCustomDomainDefault,
 		ReadContext:   readCustomDomainDefault,
 		UpdateContext: updateCustomDomainDefault,
@@ -31,7 +32,7 @@ func NewDefaultResource() *schema.Resource {
 				Description: "The custom domain name or canonical domain name to set as the default domain for the tenant.",
 			},
 		},
-	}
+	})
 }
 
 func createCustomDomainDefault(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -6,16 +6,17 @@ import (
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/auth0/terraform-provider-auth0/internal/config"
 	internalError "github.com/auth0/terraform-provider-auth0/internal/error"
+	internalSchema "github.com/auth0/terraform-provider-auth0/internal/schema"
+	"github.com/auth0/terraform-provider-auth0/internal/wait"
 )
 
 // NewVerificationResource will return a new auth0_custom_domain_verification resource.
 func NewVerificationResource() *schema.Resource {
-	return &schema.Resource{
+	return internalSchema.WithIDIdentity(&schema.Resource{
 		CreateContext: createCustomDomainVerification,
 		ReadContext:   readCustomDomainVerification,
 		DeleteContext: deleteCustomDomainVerification,
@@ -50,22 +51,26 @@ func NewVerificationResource() *schema.Resource {
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(5 * time.Minute),
 		},
-	}
+	})
 }
 
 func createCustomDomainVerification(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	api := meta.(*config.Config).GetAPI()
 
-	err := retry.RetryContext(ctx, data.Timeout(schema.TimeoutCreate), func() *retry.RetryError {
-		customDomainVerification, err := api.CustomDomain.Verify(ctx, data.Get("custom_domain_id").(string))
+	customDomainID := data.Get("custom_domain_id").(string)
+
+	err := wait.Until(ctx, wait.Options{
+		Operation: fmt.Sprintf("custom domain %q to be verified", customDomainID),
+		Timeout:   data.Timeout(schema.TimeoutCreate),
+	}, func(ctx context.Context) (bool, string, error) {
+		customDomainVerification, err := api.CustomDomain.Verify(ctx, customDomainID)
 		if err != nil {
-			return retry.NonRetryableError(err)
+			return false, "", err
 		}
 
-		if customDomainVerification.GetStatus() != "ready" {
-			return retry.RetryableError(
-				fmt.Errorf("custom domain has status %q", customDomainVerification.GetStatus()),
-			)
+		status := customDomainVerification.GetStatus()
+		if status != "ready" {
+			return false, status, nil
 		}
 
 		data.SetId(customDomainVerification.GetID())
@@ -74,10 +79,10 @@ func createCustomDomainVerification(ctx context.Context, data *schema.ResourceDa
 		// succeeds for the first time. Therefore, we set it on the resource in
 		// the creation routine only, and never touch it again.
 		if err := data.Set("cname_api_key", customDomainVerification.GetCNAMEAPIKey()); err != nil {
-			return retry.NonRetryableError(err)
+			return false, status, err
 		}
 
-		return nil
+		return true, status, nil
 	})
 	if err != nil {
 		return diag.FromErr(err)
@@ -12,11 +12,12 @@ import (
 
 	"github.com/auth0/terraform-provider-auth0/internal/config"
 	internalError "github.com/auth0/terraform-provider-auth0/internal/error"
+	internalSchema "github.com/auth0/terraform-provider-auth0/internal/schema"
 )
 
 // NewResource will return a new auth0_email_provider resource.
 func NewResource() *schema.Resource {
-	return &schema.Resource{
+	return internalSchema.WithIDIdentity(&schema.Resource{
 		CreateContext: createEmailProvider,
 		ReadContext:   readEmailProvider,
 		UpdateContext: updateEmailProvider,
@@ -204,7 +205,7 @@ func NewResource() *schema.Resource {
 				},
 			},
 		},
-	}
+	})
 }
 
 func createEmailProvider(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -12,11 +12,12 @@ import (
 
 	"github.com/auth0/terraform-provider-auth0/internal/config"
 	internalError "github.com/auth0/terraform-provider-auth0/internal/error"
+	internalSchema "github.com/auth0/terraform-provider-auth0/internal/schema"
 )
 
 // NewTemplateResource will return a new auth0_email_template resource.
 func NewTemplateResource() *schema.Resource {
-	return &schema.Resource{
+	return internalSchema.WithIDIdentity(&schema.Resource{
 		CreateContext: createEmailTemplate,
 		ReadContext:   readEmailTemplate,
 		UpdateContext: updateEmailTemplate,
@@ -99,7 +100,7 @@ func NewTemplateResource() *schema.Resource {
 					"should be included in the redirect (false). Defaults to `true`.",
 			},
 		},
-	}
+	})
 }
 
 func createEmailTemplate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -2,9 +2,30 @@ package encryptionkeymanager
 
 import (
 	"github.com/auth0/go-auth0/management"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
+func flattenEncryptionKeyManager(data *schema.ResourceData, encryptionKeys []*management.EncryptionKey) diag.Diagnostics {
+	if data.Get("customer_provided_root_key.#").(int) > 0 {
+		// First try to find a key that is going through the activation process.
+		rootKey := getKeyByTypeAndState("customer-provided-root-key", "pre-activation", encryptionKeys)
+
+		if rootKey == nil {
+			// If we didn't find one, try to find a key that is already active.
+			rootKey = getKeyByTypeAndState("customer-provided-root-key", "active", encryptionKeys)
+		}
+
+		if rootKey != nil {
+			if err := data.Set("customer_provided_root_key", flattenCustomerProvidedRootKey(data, rootKey, nil)); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+	}
+
+	return diag.FromErr(data.Set("encryption_keys", flattenEncryptionKeys(encryptionKeys)))
+}
+
 func flattenCustomerProvidedRootKey(data *schema.ResourceData, rootKey *management.EncryptionKey, wrappingKey *management.WrappingKey) []interface{} {
 	const timeRFC3339WithMilliseconds = "2006-01-02T15:04:05.000Z07:00"
 
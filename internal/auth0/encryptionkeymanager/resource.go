@@ -3,6 +3,8 @@ package encryptionkeymanager
 import (
 	"context"
 	"fmt"
+	"slices"
+	"time"
 
 	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -14,18 +16,34 @@ import (
 
 	"github.com/auth0/terraform-provider-auth0/internal/config"
 	internalError "github.com/auth0/terraform-provider-auth0/internal/error"
+	internalSchema "github.com/auth0/terraform-provider-auth0/internal/schema"
 	"github.com/auth0/terraform-provider-auth0/internal/value"
 	"github.com/auth0/terraform-provider-auth0/internal/wait"
 )
 
+const (
+	// defaultKeyStateTimeout is the default of the timeouts bounding
+	// how long to wait for the encryption keys to change their state.
+	defaultKeyStateTimeout = 1 * time.Minute
+
+	// keyStatePollInterval is the delay before polling
+	// the state of an encryption key for the second time.
+	keyStatePollInterval = 100 * time.Millisecond
+)
+
 // NewEncryptionKeyManagerResource will return a new auth0_encryption_key_manager resource.
 func NewEncryptionKeyManagerResource() *schema.Resource {
-	return &schema.Resource{
+	return internalSchema.WithIDIdentity(&schema.Resource{
 		CreateContext: createEncryptionKeyManager,
 		UpdateContext: updateEncryptionKeyManager,
 		ReadContext:   readEncryptionKeyManager,
 		DeleteContext: deleteEncryptionKeyManager,
-		Description:   "Resource to allow the rekeying of your tenant master key.",
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultKeyStateTimeout),
+			Update: schema.DefaultTimeout(defaultKeyStateTimeout),
+			Delete: schema.DefaultTimeout(defaultKeyStateTimeout),
+		},
+		Description: "Resource to allow the rekeying of your tenant master key.",
 		Schema: map[string]*schema.Schema{
 			"key_rotation_id": {
 				Type:        schema.TypeString,
@@ -142,7 +160,7 @@ func NewEncryptionKeyManagerResource() *schema.Resource {
 				},
 			},
 		},
-	}
+	})
 }
 
 func createEncryptionKeyManager(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -155,12 +173,25 @@ func updateEncryptionKeyManager(ctx context.Context, data *schema.ResourceData,
 	api := meta.(*config.Config).GetAPI()
 	config := data.GetRawConfig()
 
+	timeout := data.Timeout(schema.TimeoutUpdate)
+	if data.IsNewResource() {
+		timeout = data.Timeout(schema.TimeoutCreate)
+	}
+
+	var rotatedKeys []*management.EncryptionKey
 	if !data.IsNewResource() && data.HasChange("key_rotation_id") {
 		keyRotationID := data.Get("key_rotation_id").(string)
 		if len(keyRotationID) > 0 {
+			previousKeyIDs := activeTenantMasterKeyIDs(data)
+
 			if err := api.EncryptionKey.Rekey(ctx); err != nil {
 				return diag.FromErr(err)
 			}
+
+			var err error
+			if rotatedKeys, err = waitForKeyRotation(ctx, api, previousKeyIDs, timeout); err != nil {
+				return diag.FromErr(err)
+			}
 		}
 	}
 
@@ -174,7 +205,7 @@ func updateEncryptionKeyManager(ctx context.Context, data *schema.ResourceData,
 		if rootKeyAttrib.IsNull() || rootKeyAttrib.LengthInt() == 0 {
 			// The customer_provided_root_key block is not present, check if there was a key.
 			if len(rootKeyID) > 0 {
-				if err := removeKey(ctx, api, rootKeyID); err != nil {
+				if err := removeKey(ctx, api, rootKeyID, timeout); err != nil {
 					return diag.FromErr(err)
 				}
 			}
@@ -187,7 +218,7 @@ func updateEncryptionKeyManager(ctx context.Context, data *schema.ResourceData,
 			})
 			if wrappedKey != nil {
 				if len(rootKeyID) > 0 && rootKeyState == "pre-activation" && len(publicWrappingKey) > 0 {
-					if err := importWrappedKey(ctx, api, auth0.String(rootKeyID), wrappedKey); err != nil {
+					if err := importWrappedKey(ctx, api, auth0.String(rootKeyID), wrappedKey, timeout); err != nil {
 						return diag.FromErr(err)
 					}
 				} else if len(rootKeyID) == 0 || len(publicWrappingKey) == 0 {
@@ -199,13 +230,20 @@ func updateEncryptionKeyManager(ctx context.Context, data *schema.ResourceData,
 			// If we don't have a root key in progress yet, or this block is newly created
 			// create a new one.
 			if len(rootKeyID) == 0 || (oldCountValue.(int) == 0 && newCountValue.(int) == 1) {
-				if rootKey, wrappingKey, err := createRootKey(ctx, api); err != nil {
+				if rootKey, wrappingKey, err := createRootKey(ctx, api, timeout); err != nil {
 					return diag.FromErr(err)
 				} else if err := data.Set("customer_provided_root_key", flattenCustomerProvidedRootKey(data, rootKey, wrappingKey)); err != nil {
 					return diag.FromErr(err)
 				}
 			}
 		}
+
+		return readEncryptionKeyManager(ctx, data, meta)
+	}
+
+	// The keys listed while waiting for the rotation are still current.
+	if rotatedKeys != nil {
+		return flattenEncryptionKeyManager(data, rotatedKeys)
 	}
 
 	return readEncryptionKeyManager(ctx, data, meta)
@@ -214,37 +252,28 @@ func updateEncryptionKeyManager(ctx context.Context, data *schema.ResourceData,
 func readEncryptionKeyManager(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	api := meta.(*config.Config).GetAPI()
 
+	encryptionKeys, err := listEncryptionKeys(ctx, api)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return flattenEncryptionKeyManager(data, encryptionKeys)
+}
+
+func listEncryptionKeys(ctx context.Context, api *management.Management) ([]*management.EncryptionKey, error) {
 	encryptionKeys := make([]*management.EncryptionKey, 0)
 	page := 0
 	for {
 		encryptionKeyList, err := api.EncryptionKey.List(ctx, management.Page(page), management.PerPage(5))
 		if err != nil {
-			return diag.FromErr(err)
+			return nil, err
 		}
 		encryptionKeys = append(encryptionKeys, encryptionKeyList.Keys...)
 		if !encryptionKeyList.HasNext() {
-			break
+			return encryptionKeys, nil
 		}
 		page++
 	}
-
-	if data.Get("customer_provided_root_key.#").(int) > 0 {
-		// First try to find a key that is going through the activation process.
-		rootKey := getKeyByTypeAndState("customer-provided-root-key", "pre-activation", encryptionKeys)
-
-		if rootKey == nil {
-			// If we didn't find one, try to find a key that is already active.
-			rootKey = getKeyByTypeAndState("customer-provided-root-key", "active", encryptionKeys)
-		}
-
-		if rootKey != nil {
-			if err := data.Set("customer_provided_root_key", flattenCustomerProvidedRootKey(data, rootKey, nil)); err != nil {
-				return diag.FromErr(err)
-			}
-		}
-	}
-
-	return diag.FromErr(data.Set("encryption_keys", flattenEncryptionKeys(encryptionKeys)))
 }
 
 func deleteEncryptionKeyManager(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -252,28 +281,34 @@ func deleteEncryptionKeyManager(ctx context.Context, data *schema.ResourceData,
 
 	rootKeyID := data.Get("customer_provided_root_key.0.key_id").(string)
 	if len(rootKeyID) > 0 {
-		return diag.FromErr(removeKey(ctx, api, rootKeyID))
+		return diag.FromErr(removeKey(ctx, api, rootKeyID, data.Timeout(schema.TimeoutDelete)))
 	}
 
 	return nil
 }
 
-func removeKey(ctx context.Context, api *management.Management, keyID string) error {
+func removeKey(ctx context.Context, api *management.Management, keyID string, timeout time.Duration) error {
 	if err := api.EncryptionKey.Delete(ctx, keyID); err != nil {
 		return err
 	}
 
 	// Wait until the key is actually destroyed.
-	return wait.Until(100, 20, func() (bool, error) {
+	return wait.ForState(ctx, wait.Options{
+		Operation:       fmt.Sprintf("encryption key %q to be destroyed", keyID),
+		Timeout:         timeout,
+		InitialInterval: keyStatePollInterval,
+	}, func(ctx context.Context) (string, error) {
 		key, err := api.EncryptionKey.Read(ctx, keyID)
-		if err != nil {
-			return false, err
-		}
-		return key.GetState() == "destroyed", nil
-	})
+		return key.GetState(), err
+	}, []string{"destroyed"})
 }
 
-func importWrappedKey(ctx context.Context, api *management.Management, keyID, wrappedKey *string) error {
+func importWrappedKey(
+	ctx context.Context,
+	api *management.Management,
+	keyID, wrappedKey *string,
+	timeout time.Duration,
+) error {
 	encryptionKey := management.EncryptionKey{
 		KID:        keyID,
 		WrappedKey: wrappedKey,
@@ -282,16 +317,21 @@ func importWrappedKey(ctx context.Context, api *management.Management, keyID, wr
 		return err
 	}
 	// Wait until the key is actually activated.
-	return wait.Until(100, 20, func() (bool, error) {
+	return wait.ForState(ctx, wait.Options{
+		Operation:       fmt.Sprintf("encryption key %q to be activated", *keyID),
+		Timeout:         timeout,
+		InitialInterval: keyStatePollInterval,
+	}, func(ctx context.Context) (string, error) {
 		key, err := api.EncryptionKey.Read(ctx, *keyID)
-		if err != nil {
-			return false, err
-		}
-		return key.GetState() == "active", nil
-	})
+		return key.GetState(), err
+	}, []string{"active"})
 }
 
-func createRootKey(ctx context.Context, api *management.Management) (*management.EncryptionKey, *management.WrappingKey, error) {
+func createRootKey(
+	ctx context.Context,
+	api *management.Management,
+	timeout time.Duration,
+) (*management.EncryptionKey, *management.WrappingKey, error) {
 	key := management.EncryptionKey{
 		Type: auth0.String("customer-provided-root-key"),
 	}
@@ -300,14 +340,18 @@ func createRootKey(ctx context.Context, api *management.Management) (*management
 	}
 
 	// Wait until the key is actually available.
-	err := wait.Until(100, 20, func() (bool, error) {
+	err := wait.Until(ctx, wait.Options{
+		Operation:       fmt.Sprintf("encryption key %q to be available", key.GetKID()),
+		Timeout:         timeout,
+		InitialInterval: keyStatePollInterval,
+	}, func(ctx context.Context) (bool, string, error) {
 		if _, err := api.EncryptionKey.Read(ctx, key.GetKID()); err != nil {
 			if internalError.IsStatusNotFound(err) {
-				return false, nil
+				return false, "not found", nil
 			}
-			return false, err
+			return false, "", err
 		}
-		return true, nil
+		return true, "found", nil
 	})
 	if err != nil {
 		return nil, nil, err
@@ -320,3 +364,49 @@ func createRootKey(ctx context.Context, api *management.Management) (*management
 
 	return &key, wrappingKey, nil
 }
+
+// activeTenantMasterKeyIDs returns the IDs of the tenant master keys that were active when last read.
+func activeTenantMasterKeyIDs(data *schema.ResourceData) []string {
+	var keyIDs []string
+	for _, encryptionKey := range data.Get("encryption_keys").([]interface{}) {
+		key, ok := encryptionKey.(map[string]interface{})
+		if ok && key["type"] == "tenant-master-key" && key["state"] == "active" {
+			keyIDs = append(keyIDs, key["key_id"].(string))
+		}
+	}
+
+	return keyIDs
+}
+
+// waitForKeyRotation waits until a tenant master key other than the previously active ones
+// is active, returning the encryption keys listed when the rotation completed.
+func waitForKeyRotation(
+	ctx context.Context,
+	api *management.Management,
+	previousKeyIDs []string,
+	timeout time.Duration,
+) ([]*management.EncryptionKey, error) {
+	var encryptionKeys []*management.EncryptionKey
+
+	err := wait.Until(ctx, wait.Options{
+		Operation:       "the encryption keys to be rotated",
+		Timeout:         timeout,
+		InitialInterval: keyStatePollInterval,
+	}, func(ctx context.Context) (bool, string, error) {
+		var err error
+		if encryptionKeys, err = listEncryptionKeys(ctx, api); err != nil {
+			return false, "", err
+		}
+
+		for _, key := range encryptionKeys {
+			if key.GetType() == "tenant-master-key" && key.GetState() == "active" &&
+				!slices.Contains(previousKeyIDs, key.GetKID()) {
+				return true, "rotated", nil
+			}
+		}
+
+		return false, "not rotated", nil
+	})
+
+	return encryptionKeys, err
+}
@@ -9,6 +9,7 @@ import (
 
 	"github.com/auth0/terraform-provider-auth0/internal/config"
 	internalError "github.com/auth0/terraform-provider-auth0/internal/error"
+	internalSchema "github.com/auth0/terraform-provider-auth0/internal/schema"
 )
 
 var webhookConfig = &schema.Resource{
@@ -194,7 +195,7 @@ var actionConfig = &schema.Resource{
 
 // NewResource returns the auth0_event_stream resource.
 func NewResource() *schema.Resource {
-	return &schema.Resource{
+	return internalSchema.WithIDIdentity(&schema.Resource{
 		CreateContext: createEventStream,
 		ReadContext:   readEventStream,
 		UpdateContext: updateEventStream,
@@ -275,7 +276,7 @@ func NewResource() *schema.Resource {
 				Description: "The ISO 8601 timestamp when the stream was last updated.",
 			},
 		},
-	}
+	})
 }
 
 func createEventStream(ctx context.Context, data *schema.ResourceData, m interface{}) diag.Diagnostics {
@@ -10,11 +10,12 @@ import (
 
 	"github.com/auth0/terraform-provider-auth0/internal/config"
 	internalError "github.com/auth0/terraform-provider-auth0/internal/error"
+	internalSchema "github.com/auth0/terraform-provider-auth0/internal/schema"
 )
 
 // NewResource will return a new auth0_flow resource.
 func NewResource() *schema.Resource {
-	return &schema.Resource{
+	return internalSchema.WithIDIdentity(&schema.Resource{
 		CreateContext: createFlow,
 		ReadContext:   readFlow,
 		UpdateContext: updateFlow,
@@ -37,7 +38,7 @@ func NewResource() *schema.Resource {
 				Description:      "Actions of the flow.",
 			},
 		},
-	}
+	})
 }
 
 func createFlow(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -11,11 +11,12 @@ import (
 
 	"github.com/auth0/terraform-provider-auth0/internal/config"
 	internalError "github.com/auth0/terraform-provider-auth0/internal/error"
+	internalSchema "github.com/auth0/terraform-provider-auth0/internal/schema"
 )
 
 // NewVaultConnectionResource will return a new auth0_flow_vault_connection resource.
 func NewVaultConnectionResource() *schema.Resource {
-	return &schema.Resource{
+	return internalSchema.WithIDIdentity(&schema.Resource{
 		CreateContext: createVaultConnection,
 		ReadContext:   readVaultConnection,
 		UpdateContext: updateVaultConnection,
@@ -87,7 +88,7 @@ func NewVaultConnectionResource() *schema.Resource {
 				Description: "Fingerprint of the vault connection.",
 			},
 		},
-	}
+	})
 }
 
 func createVaultConnection(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
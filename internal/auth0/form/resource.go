@@ -12,11 +12,12 @@ import (
 
 	"github.com/auth0/terraform-provider-auth0/internal/config"
 	internalError "github.com/auth0/terraform-provider-auth0/internal/error"
+	internalSchema "github.com/auth0/terraform-provider-auth0/internal/schema"
 )
 
 // NewResource will return a new auth0_form resource.
 func NewResource() *schema.Resource {
-	return &schema.Resource{
+	return internalSchema.WithIDIdentity(&schema.Resource{
 		CreateContext: createForm,
 		ReadContext:   readForm,
 		UpdateContext: updateForm,
@@ -72,7 +73,7 @@ func NewResource() *schema.Resource {
 			"languages": formLanguageSchema,
 			"messages":  formMessagesSchema,
 		},
-	}
+	})
 }
 
 var formLanguageSchema = &schema.Schema{
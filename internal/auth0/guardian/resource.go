@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"github.com/auth0/terraform-provider-auth0/internal/config"
+	internalSchema "github.com/auth0/terraform-provider-auth0/internal/schema"
 	internalValidation "github.com/auth0/terraform-provider-auth0/internal/validation"
 )
 
@@ -22,7 +23,7 @@ const phoneProviderDeprecationMessage = "This field is deprecated in favor of th
 
 // NewResource will return a new auth0_guardian resource.
 func NewResource() *schema.Resource {
-	return &schema.Resource{
+	return internalSchema.WithIDIdentity(&schema.Resource{
 		CreateContext: createGuardian,
 		ReadContext:   readGuardian,
 		UpdateContext: updateGuardian,
@@ -417,7 +418,7 @@ func NewResource() *schema.Resource {
 				},
 			},
 		},
-	}
+	})
 }
 
 func createGuardian(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
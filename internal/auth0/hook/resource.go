@@ -9,12 +9,13 @@ import (
 
 	"github.com/auth0/terraform-provider-auth0/internal/config"
 	internalError "github.com/auth0/terraform-provider-auth0/internal/error"
+	internalSchema "github.com/auth0/terraform-provider-auth0/internal/schema"
 	"github.com/auth0/terraform-provider-auth0/internal/value"
 )
 
 // NewResource will return a new auth0_hook resource.
 func NewResource() *schema.Resource {
-	return &schema.Resource{
+	return internalSchema.WithIDIdentity(&schema.Resource{
 		DeprecationMessage: "This resource is deprecated. Refer to the [guide on how to migrate from hooks to actions](https://auth0.com/docs/customize/actions/migrate/migrate-from-hooks-to-actions) " +
 			"and manage your actions using the `auth0_action` resource.",
 		CreateContext: createHook,
@@ -78,7 +79,7 @@ func NewResource() *schema.Resource {
 				Description: "Whether the hook is enabled, or disabled.",
 			},
 		},
-	}
+	})
 }
 
 func createHook(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -11,6 +11,7 @@ import (
 
 	"github.com/auth0/terraform-provider-auth0/internal/config"
 	internalError "github.com/auth0/terraform-provider-auth0/internal/error"
+	internalSchema "github.com/auth0/terraform-provider-auth0/internal/schema"
 )
 
 var validLogStreamTypes = []string{
@@ -26,7 +27,7 @@ var validLogStreamTypes = []string{
 
 // NewResource will return a new auth0_log_stream resource.
 func NewResource() *schema.Resource {
-	return &schema.Resource{
+	return internalSchema.WithIDIdentity(&schema.Resource{
 		CreateContext: createLogStream,
 		ReadContext:   readLogStream,
 		UpdateContext: updateLogStream,
@@ -388,7 +389,7 @@ func NewResource() *schema.Resource {
 				Description: "The optional datetime (ISO 8601) to start streaming logs from.",
 			},
 		},
-	}
+	})
 }
 
 func createLogStream(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -10,11 +10,12 @@ import (
 
 	"github.com/auth0/terraform-provider-auth0/internal/config"
 	internalError "github.com/auth0/terraform-provider-auth0/internal/error"
+	internalSchema "github.com/auth0/terraform-provider-auth0/internal/schema"
 )
 
 // NewResource will return a new auth0_network_acl resource.
 func NewResource() *schema.Resource {
-	return &schema.Resource{
+	return internalSchema.WithIDIdentity(&schema.Resource{
 		CreateContext: createNetworkACL,
 		ReadContext:   readNetworkACL,
 		UpdateContext: updateNetworkACL,
@@ -41,7 +42,7 @@ func NewResource() *schema.Resource {
 			},
 			"rule": networkACLRuleSchema,
 		},
-	}
+	})
 }
 
 var networkACLRuleSchema = &schema.Schema{
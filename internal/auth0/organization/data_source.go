@@ -29,6 +29,8 @@ func NewDataSource() *schema.Resource {
 
 func dataSourceSchema() map[string]*schema.Schema {
 	dataSourceSchema := internalSchema.TransformResourceToDataSource(NewResource().Schema)
+	delete(dataSourceSchema, "adopt_existing")
+
 	dataSourceSchema["organization_id"] = &schema.Schema{
 		Type:         schema.TypeString,
 		Optional:     true,
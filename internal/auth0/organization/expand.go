@@ -24,8 +24,14 @@ func expandOrganization(data *schema.ResourceData) *management.Organization {
 		TokenQuota:             commons.ExpandTokenQuota(cfg.GetAttr("token_quota")),
 	}
 
-	if data.HasChange("metadata") {
-		organization.Metadata = value.MapOfStrings(cfg.GetAttr("metadata"))
+	// The metadata_all attribute holds the metadata
+	// merged with the default metadata of the provider.
+	if data.HasChange("metadata_all") {
+		metadata := make(map[string]string)
+		for key, value := range data.Get("metadata_all").(map[string]interface{}) {
+			metadata[key] = value.(string)
+		}
+		organization.Metadata = &metadata
 	}
 
 	return organization
@@ -7,16 +7,26 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/auth0/terraform-provider-auth0/internal/auth0/commons"
+	"github.com/auth0/terraform-provider-auth0/internal/config"
 )
 
-func flattenOrganization(data *schema.ResourceData, organization *management.Organization) error {
+func flattenOrganization(
+	data *schema.ResourceData,
+	organization *management.Organization,
+	defaultMetadata config.DefaultMetadata,
+) error {
 	result := multierror.Append(
 		data.Set("name", organization.GetName()),
 		data.Set("display_name", organization.GetDisplayName()),
 		data.Set("third_party_client_access", organization.GetThirdPartyClientAccess()),
 		data.Set("is_app_entitlement_active", organization.GetIsAppEntitlementActive()),
 		data.Set("branding", flattenOrganizationBranding(organization.GetBranding())),
-		data.Set("metadata", organization.GetMetadata()),
+		data.Set("metadata", config.SuppressDefaultMetadata(
+			defaultMetadata,
+			organization.GetMetadata(),
+			data.Get("metadata").(map[string]interface{}),
+		)),
+		data.Set("metadata_all", organization.GetMetadata()),
 		data.Set("token_quota", commons.FlattenTokenQuota(organization.GetTokenQuota())),
 	)
 
@@ -31,7 +41,7 @@ func flattenOrganizationForDataSource(
 	clientGrants []*management.ClientGrant,
 ) error {
 	result := multierror.Append(
-		flattenOrganization(data, organization),
+		flattenOrganization(data, organization, nil),
 		data.Set("connections", flattenOrganizationEnabledConnections(connections)),
 		data.Set("members", flattenOrganizationMembersSlice(members)),
 		data.Set("client_grants", flattenOrganizationClientGrantsSlice(clientGrants)),
@@ -0,0 +1,30 @@
+package organization
+
+import (
+	"context"
+
+	"github.com/auth0/terraform-provider-auth0/internal/config"
+	internalError "github.com/auth0/terraform-provider-auth0/internal/error"
+	internalSchema "github.com/auth0/terraform-provider-auth0/internal/schema"
+)
+
+// ImportIDResolvers resolve the human-readable import IDs of organizations, e.g. "name:acme".
+var ImportIDResolvers = internalSchema.ImportIDResolvers{
+	"name": resolveOrganizationIDByName,
+}
+
+func resolveOrganizationIDByName(ctx context.Context, meta interface{}, name string) (string, error) {
+	var ids []string
+
+	// Organization names are unique, so they can be read directly.
+	organization, err := meta.(*config.Config).GetAPI().Organization.ReadByName(ctx, name)
+	switch {
+	case internalError.IsStatusNotFound(err):
+	case err != nil:
+		return "", err
+	default:
+		ids = append(ids, organization.GetID())
+	}
+
+	return internalSchema.UniqueImportID("organization", "name", name, ids)
+}
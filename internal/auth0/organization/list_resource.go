@@ -0,0 +1,92 @@
+package organization
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/auth0/terraform-provider-auth0/internal/config"
+	internalSchema "github.com/auth0/terraform-provider-auth0/internal/schema"
+)
+
+// NewListResource will return a new auth0_organization list resource.
+func NewListResource() list.ListResource {
+	return internalSchema.NewListResource(internalSchema.ListResourceConfig{
+		TypeName: "_organization",
+		Resource: NewResource,
+		Schema: schema.Schema{
+			MarkdownDescription: "With this list resource, you can find the organizations of the tenant, " +
+				"e.g. to import them with `terraform query`.",
+			Attributes: map[string]schema.Attribute{
+				"name_filter": schema.StringAttribute{
+					Optional:            true,
+					MarkdownDescription: "Filter organizations by name or display name (partial matches supported).",
+				},
+				"client_id": schema.StringAttribute{
+					Optional: true,
+					MarkdownDescription: "Only list the organizations associated with the client (application) " +
+						"with this ID, as returned with `include_client_association_for` (EA only).",
+				},
+			},
+		},
+		List: listOrganizations,
+	})
+}
+
+type listOrganizationsModel struct {
+	NameFilter types.String `tfsdk:"name_filter"`
+	ClientID   types.String `tfsdk:"client_id"`
+}
+
+func listOrganizations(
+	ctx context.Context,
+	filters tfsdk.Config,
+	meta interface{},
+	yield func(internalSchema.ListItem) bool,
+) diag.Diagnostics {
+	apiv3 := meta.(*config.Config).GetAPIV3()
+
+	var data listOrganizationsModel
+	diagnostics := filters.Get(ctx, &data)
+	if diagnostics.HasError() {
+		return diagnostics
+	}
+
+	clientID := data.ClientID.ValueString()
+
+	organizations, err := fetchAllOrganizations(ctx, apiv3, clientID)
+	if err != nil {
+		diagnostics.AddError("Failed to List Organizations", err.Error())
+		return diagnostics
+	}
+
+	nameFilter := data.NameFilter.ValueString()
+
+	for _, organization := range organizations {
+		if clientID != "" && organization.Client == nil {
+			continue
+		}
+
+		if nameFilter != "" &&
+			!strings.Contains(organization.GetName(), nameFilter) &&
+			!strings.Contains(organization.GetDisplayName(), nameFilter) {
+			continue
+		}
+
+		displayName := organization.GetDisplayName()
+		if displayName == "" {
+			displayName = organization.GetName()
+		}
+
+		if !yield(internalSchema.ListItem{ID: organization.GetID(), DisplayName: displayName}) {
+			return diagnostics
+		}
+	}
+
+	return diagnostics
+}
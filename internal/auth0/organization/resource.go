@@ -2,28 +2,28 @@ package organization
 
 import (
 	"context"
+	"fmt"
 	"net/http"
 
-	"github.com/auth0/terraform-provider-auth0/internal/auth0/commons"
-
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"github.com/auth0/terraform-provider-auth0/internal/config"
 	internalError "github.com/auth0/terraform-provider-auth0/internal/error"
+	internalSchema "github.com/auth0/terraform-provider-auth0/internal/schema"
 )
 
 // NewResource will return a new auth0_organization resource.
 func NewResource() *schema.Resource {
-	return &schema.Resource{
+	return internalSchema.WithIDIdentity(&schema.Resource{
 		CreateContext: createOrganization,
 		ReadContext:   readOrganization,
 		UpdateContext: updateOrganization,
 		DeleteContext: deleteOrganization,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: internalSchema.ImportStateResolvingID(ImportIDResolvers, schema.ImportStatePassthroughContext),
 		},
+		CustomizeDiff: config.PlanAllMetadata("metadata", "metadata_all"),
 		Description: "The Organizations feature represents a broad update to the Auth0 platform that allows our " +
 			"business-to-business (B2B) customers to better manage their partners and customers, and to " +
 			"customize the ways that end-users access their applications. Auth0 customers can use " +
@@ -31,65 +31,18 @@ func NewResource() *schema.Resource {
 			"\n    membership.\n  - Configure branded, federated login flows for each business." +
 			"\n  - Build administration capabilities into their products, using Organizations" +
 			"\n    APIs, so that those businesses can manage their own organizations.",
-		Schema: map[string]*schema.Schema{
-			"name": {
-				Type:        schema.TypeString,
-				Required:    true,
-				Description: "The name of this organization.",
-			},
-			"display_name": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Description: "Friendly name of this organization.",
-			},
-			"third_party_client_access": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validation.StringInSlice([]string{"allow", "block"}, false),
-				Description: "Controls whether this organization can be used in user flows with third-party clients. " +
-					"Available values are `allow` or `block`. Defaults to `block`.",
-			},
-			"is_app_entitlement_active": {
-				Type:     schema.TypeBool,
-				Optional: true,
-				Computed: true,
-				Description: "Controls whether this organization's app entitlement is active, determining whether " +
-					"members of this organization can access applications associated with it (EA only). This is " +
-					"distinct from `auth0_client_grant`'s `organization_usage` attribute, which controls whether " +
-					"organizations can be used with client credentials exchanges for a given client grant.",
-			},
-			"branding": {
-				Type:        schema.TypeList,
-				Optional:    true,
-				Computed:    true,
-				MaxItems:    1,
-				Description: "Defines how to style the login pages.",
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"logo_url": {
-							Type:        schema.TypeString,
-							Optional:    true,
-							Description: "URL of logo to display on login page.",
-						},
-						"colors": {
-							Type:        schema.TypeMap,
-							Optional:    true,
-							Elem:        &schema.Schema{Type: schema.TypeString},
-							Description: "Color scheme used to customize the login pages.",
-						},
-					},
-				},
-			},
-			"metadata": {
-				Type:        schema.TypeMap,
-				Optional:    true,
-				Elem:        &schema.Schema{Type: schema.TypeString},
-				Description: "Metadata associated with the organization. Maximum of 10 metadata properties allowed.",
-			},
-			"token_quota": commons.TokenQuotaSchema(),
-		},
-	}
+		Schema: resourceSchema,
+	})
+}
+
+var apiErrorAttributePaths = internalError.AttributePaths{
+	Schema: resourceSchema,
+}
+
+// diagnosticsFromAPIError points the validation errors returned by the
+// Management API at the attributes of the organization that caused them.
+func diagnosticsFromAPIError(err error) diag.Diagnostics {
+	return internalError.APIErrorDiagnostics(err, apiErrorAttributePaths)
 }
 
 func createOrganization(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -98,7 +51,25 @@ func createOrganization(ctx context.Context, data *schema.ResourceData, meta int
 	organization := expandOrganization(data)
 
 	if err := api.Organization.Create(ctx, organization); err != nil {
-		return diag.FromErr(err)
+		diagnostics, adopted := internalError.AdoptExisting(
+			ctx,
+			"auth0_organization",
+			fmt.Sprintf("name %q", organization.GetName()),
+			data,
+			err,
+			internalError.AdoptExistingEnabled(data, meta.(*config.Config).GetAdoptExisting()),
+			func(ctx context.Context) (string, error) {
+				return resolveOrganizationIDByName(ctx, meta, organization.GetName())
+			},
+		)
+		if !adopted {
+			return diagnosticsFromAPIError(err)
+		}
+		if diagnostics.HasError() {
+			return diagnostics
+		}
+
+		return append(diagnostics, updateOrganization(ctx, data, meta)...)
 	}
 
 	data.SetId(organization.GetID())
@@ -114,7 +85,7 @@ func readOrganization(ctx context.Context, data *schema.ResourceData, meta inter
 		return internalError.HandleReadAPIError("auth0_organization", data, err)
 	}
 
-	return diag.FromErr(flattenOrganization(data, organization))
+	return diag.FromErr(flattenOrganization(data, organization, meta.(*config.Config).GetDefaultMetadata()))
 }
 
 func updateOrganization(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -123,13 +94,13 @@ func updateOrganization(ctx context.Context, data *schema.ResourceData, meta int
 	organization := expandOrganization(data)
 
 	if err := api.Organization.Update(ctx, data.Id(), organization); err != nil {
-		return diag.FromErr(internalError.HandleAPIError(data, err))
+		return diagnosticsFromAPIError(internalError.HandleAPIError(data, err))
 	}
 
 	nullFields := fetchNullableFields(data)
 	if len(nullFields) != 0 {
 		if err := api.Request(ctx, http.MethodPatch, api.URI("organizations", data.Id()), nullFields); err != nil {
-			return diag.FromErr(err)
+			return diagnosticsFromAPIError(err)
 		}
 	}
 
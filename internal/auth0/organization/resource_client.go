@@ -7,6 +7,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
+	"github.com/auth0/terraform-provider-auth0/internal/auth0/client"
 	"github.com/auth0/terraform-provider-auth0/internal/config"
 	internalError "github.com/auth0/terraform-provider-auth0/internal/error"
 	internalSchema "github.com/auth0/terraform-provider-auth0/internal/schema"
@@ -14,7 +15,7 @@ import (
 
 // NewClientResource will return a new auth0_organization_client resource (EA only).
 func NewClientResource() *schema.Resource {
-	return &schema.Resource{
+	return internalSchema.WithGroupIdentity(&schema.Resource{
 		Description: "With this resource, you can manage the association between an organization and an " +
 			"application (client), controlling that application's entitlement to the organization (EA only). " +
 			"This is distinct from `auth0_organization_client_grant`, which associates an organization with a " +
@@ -24,10 +25,17 @@ func NewClientResource() *schema.Resource {
 		UpdateContext: updateOrganizationClient,
 		DeleteContext: deleteOrganizationClient,
 		Importer: &schema.ResourceImporter{
-			StateContext: internalSchema.ImportResourceGroupID("organization_id", "client_id"),
+			StateContext: internalSchema.ImportResourceGroupIDResolving(
+				map[string]internalSchema.ImportIDResolvers{
+					"organization_id": ImportIDResolvers,
+					"client_id":       client.ImportIDResolvers,
+				},
+				"organization_id",
+				"client_id",
+			),
 		},
 		Schema: clientResourceSchema(),
-	}
+	}, "organization_id", "client_id")
 }
 
 func clientResourceSchema() map[string]*schema.Schema {
@@ -13,13 +13,19 @@ import (
 
 // NewOrganizationClientGrantResource will return a new auth0_organization_client_grant resource.
 func NewOrganizationClientGrantResource() *schema.Resource {
-	return &schema.Resource{
+	return internalSchema.WithGroupIdentity(&schema.Resource{
 		Description:   "With this resource, you can manage a client grant associated with an organization.",
 		CreateContext: createOrganizationClientGrant,
 		ReadContext:   readOrganizationClientGrant,
 		DeleteContext: deleteOrganizationClientGrant,
 		Importer: &schema.ResourceImporter{
-			StateContext: internalSchema.ImportResourceGroupID("organization_id", "grant_id"),
+			StateContext: internalSchema.ImportResourceGroupIDResolving(
+				map[string]internalSchema.ImportIDResolvers{
+					"organization_id": ImportIDResolvers,
+				},
+				"organization_id",
+				"grant_id",
+			),
 		},
 		Schema: map[string]*schema.Schema{
 			"organization_id": {
@@ -35,7 +41,7 @@ func NewOrganizationClientGrantResource() *schema.Resource {
 				Description: "A Client Grant ID to add to the organization.",
 			},
 		},
-	}
+	}, "organization_id", "grant_id")
 }
 
 func createOrganizationClientGrant(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
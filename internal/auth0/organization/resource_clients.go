@@ -16,6 +16,7 @@ import (
 
 	"github.com/auth0/terraform-provider-auth0/internal/config"
 	internalError "github.com/auth0/terraform-provider-auth0/internal/error"
+	internalSchema "github.com/auth0/terraform-provider-auth0/internal/schema"
 )
 
 // organizationClientsBatchSize is the maximum number of clients the batch association
@@ -24,7 +25,7 @@ const organizationClientsBatchSize = 10
 
 // NewClientsResource will return a new auth0_organization_clients (1:many) resource (EA only).
 func NewClientsResource() *schema.Resource {
-	return &schema.Resource{
+	return internalSchema.WithIDIdentity(&schema.Resource{
 		Description: "With this resource, you can manage all of the client (application) associations of an " +
 			"organization, controlling those applications' entitlement to the organization (EA only). " +
 			"This resource is authoritative: it manages the full set of associations, so it must not be " +
@@ -66,7 +67,7 @@ func NewClientsResource() *schema.Resource {
 				},
 			},
 		},
-	}
+	})
 }
 
 func createOrganizationClients(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -7,6 +7,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
+	"github.com/auth0/terraform-provider-auth0/internal/auth0/connection"
 	"github.com/auth0/terraform-provider-auth0/internal/config"
 	internalError "github.com/auth0/terraform-provider-auth0/internal/error"
 	internalSchema "github.com/auth0/terraform-provider-auth0/internal/schema"
@@ -14,14 +15,21 @@ import (
 
 // NewConnectionResource will return a new auth0_organization_connection resource.
 func NewConnectionResource() *schema.Resource {
-	return &schema.Resource{
+	return internalSchema.WithGroupIdentity(&schema.Resource{
 		Description:   "With this resource, you can manage enabled connections on an organization.",
 		CreateContext: createOrganizationConnection,
 		ReadContext:   readOrganizationConnection,
 		UpdateContext: updateOrganizationConnection,
 		DeleteContext: deleteOrganizationConnection,
 		Importer: &schema.ResourceImporter{
-			StateContext: internalSchema.ImportResourceGroupID("organization_id", "connection_id"),
+			StateContext: internalSchema.ImportResourceGroupIDResolving(
+				map[string]internalSchema.ImportIDResolvers{
+					"organization_id": ImportIDResolvers,
+					"connection_id":   connection.ImportIDResolvers,
+				},
+				"organization_id",
+				"connection_id",
+			),
 		},
 		Schema: map[string]*schema.Schema{
 			"organization_id": {
@@ -88,7 +96,7 @@ func NewConnectionResource() *schema.Resource {
 				Description: "The strategy of the enabled connection.",
 			},
 		},
-	}
+	}, "organization_id", "connection_id")
 }
 
 func createOrganizationConnection(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -18,12 +18,13 @@ import (
 
 	"github.com/auth0/terraform-provider-auth0/internal/config"
 	internalError "github.com/auth0/terraform-provider-auth0/internal/error"
+	internalSchema "github.com/auth0/terraform-provider-auth0/internal/schema"
 	"github.com/auth0/terraform-provider-auth0/internal/value"
 )
 
 // NewConnectionsResource will return a new auth0_organization_connections (1:many) resource.
 func NewConnectionsResource() *schema.Resource {
-	return &schema.Resource{
+	return internalSchema.WithIDIdentity(&schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"organization_id": {
 				Type:        schema.TypeString,
@@ -98,7 +99,7 @@ func NewConnectionsResource() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Description: "With this resource, you can manage enabled connections on an organization.",
-	}
+	})
 }
 
 // organizationConnectionSetHash computes the set hash using only fields that
@@ -16,13 +16,19 @@ import (
 
 // NewDiscoveryDomainResource will return a new auth0_organization_discovery_domain resource.
 func NewDiscoveryDomainResource() *schema.Resource {
-	return &schema.Resource{
+	return internalSchema.WithGroupIdentity(&schema.Resource{
 		CreateContext: createOrganizationDiscoveryDomain,
 		ReadContext:   readOrganizationDiscoveryDomain,
 		UpdateContext: updateOrganizationDiscoveryDomain,
 		DeleteContext: deleteOrganizationDiscoveryDomain,
 		Importer: &schema.ResourceImporter{
-			StateContext: internalSchema.ImportResourceGroupID("organization_id", "id"),
+			StateContext: internalSchema.ImportResourceGroupIDResolving(
+				map[string]internalSchema.ImportIDResolvers{
+					"organization_id": ImportIDResolvers,
+				},
+				"organization_id",
+				"id",
+			),
 		},
 		Description: "Manage organization discovery domains for Home Realm Discovery. These domains help automatically route users to the correct organization based on their email domain.",
 		Schema: map[string]*schema.Schema{
@@ -66,7 +72,7 @@ func NewDiscoveryDomainResource() *schema.Resource {
 				Description: "Indicates whether this domain should be used for organization discovery during login.",
 			},
 		},
-	}
+	}, "organization_id", "id")
 }
 
 func createOrganizationDiscoveryDomain(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
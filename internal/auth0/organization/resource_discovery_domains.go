@@ -13,12 +13,13 @@ import (
 
 	"github.com/auth0/terraform-provider-auth0/internal/config"
 	internalError "github.com/auth0/terraform-provider-auth0/internal/error"
+	internalSchema "github.com/auth0/terraform-provider-auth0/internal/schema"
 	"github.com/auth0/terraform-provider-auth0/internal/value"
 )
 
 // NewDiscoveryDomainsResource will return a new auth0_organization_discovery_domains (1:many) resource.
 func NewDiscoveryDomainsResource() *schema.Resource {
-	return &schema.Resource{
+	return internalSchema.WithIDIdentity(&schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"organization_id": {
 				Type:        schema.TypeString,
@@ -76,7 +77,7 @@ func NewDiscoveryDomainsResource() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Description: "With this resource, you can manage discovery domains on an organization.",
-	}
+	})
 }
 
 func createOrganizationDiscoveryDomains(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
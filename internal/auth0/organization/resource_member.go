@@ -13,13 +13,19 @@ import (
 
 // NewMemberResource will return a new auth0_organization_member resource.
 func NewMemberResource() *schema.Resource {
-	return &schema.Resource{
+	return internalSchema.WithGroupIdentity(&schema.Resource{
 		Description:   "This resource is used to manage the assignment of members and their roles within an organization.",
 		CreateContext: createOrganizationMember,
 		ReadContext:   readOrganizationMember,
 		DeleteContext: deleteOrganizationMember,
 		Importer: &schema.ResourceImporter{
-			StateContext: internalSchema.ImportResourceGroupID("organization_id", "user_id"),
+			StateContext: internalSchema.ImportResourceGroupIDResolving(
+				map[string]internalSchema.ImportIDResolvers{
+					"organization_id": ImportIDResolvers,
+				},
+				"organization_id",
+				"user_id",
+			),
 		},
 		Schema: map[string]*schema.Schema{
 			"organization_id": {
@@ -35,7 +41,7 @@ func NewMemberResource() *schema.Resource {
 				Description: "ID of the user to add as an organization member.",
 			},
 		},
-	}
+	}, "organization_id", "user_id")
 }
 
 func createOrganizationMember(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
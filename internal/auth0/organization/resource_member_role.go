@@ -7,6 +7,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
+	"github.com/auth0/terraform-provider-auth0/internal/auth0/role"
 	"github.com/auth0/terraform-provider-auth0/internal/config"
 	internalError "github.com/auth0/terraform-provider-auth0/internal/error"
 	internalSchema "github.com/auth0/terraform-provider-auth0/internal/schema"
@@ -14,13 +15,21 @@ import (
 
 // NewMemberRoleResource will return a new auth0_organization_member_role (1:1) resource.
 func NewMemberRoleResource() *schema.Resource {
-	return &schema.Resource{
+	return internalSchema.WithGroupIdentity(&schema.Resource{
 		Description:   "This resource is used to manage the roles assigned to an organization member.",
 		CreateContext: createOrganizationMemberRole,
 		ReadContext:   readOrganizationMemberRole,
 		DeleteContext: deleteOrganizationMemberRole,
 		Importer: &schema.ResourceImporter{
-			StateContext: internalSchema.ImportResourceGroupID("organization_id", "user_id", "role_id"),
+			StateContext: internalSchema.ImportResourceGroupIDResolving(
+				map[string]internalSchema.ImportIDResolvers{
+					"organization_id": ImportIDResolvers,
+					"role_id":         role.ImportIDResolvers,
+				},
+				"organization_id",
+				"user_id",
+				"role_id",
+			),
 		},
 		Schema: map[string]*schema.Schema{
 			"organization_id": {
@@ -52,7 +61,7 @@ func NewMemberRoleResource() *schema.Resource {
 				Description: "Description of the role.",
 			},
 		},
-	}
+	}, "organization_id", "user_id", "role_id")
 }
 
 func createOrganizationMemberRole(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -15,14 +15,20 @@ import (
 
 // NewMemberRolesResource will return a new auth0_organization_member_roles (1:many) resource.
 func NewMemberRolesResource() *schema.Resource {
-	return &schema.Resource{
+	return internalSchema.WithGroupIdentity(&schema.Resource{
 		Description:   "This resource is used to manage the roles assigned to an organization member.",
 		CreateContext: createOrganizationMemberRoles,
 		ReadContext:   readOrganizationMemberRoles,
 		UpdateContext: updateOrganizationMemberRoles,
 		DeleteContext: deleteOrganizationMemberRoles,
 		Importer: &schema.ResourceImporter{
-			StateContext: internalSchema.ImportResourceGroupID("organization_id", "user_id"),
+			StateContext: internalSchema.ImportResourceGroupIDResolving(
+				map[string]internalSchema.ImportIDResolvers{
+					"organization_id": ImportIDResolvers,
+				},
+				"organization_id",
+				"user_id",
+			),
 		},
 		Schema: map[string]*schema.Schema{
 			"organization_id": {
@@ -46,7 +52,7 @@ func NewMemberRolesResource() *schema.Resource {
 				Required:    true,
 			},
 		},
-	}
+	}, "organization_id", "user_id")
 }
 
 func createOrganizationMemberRoles(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -12,12 +12,13 @@ import (
 
 	"github.com/auth0/terraform-provider-auth0/internal/config"
 	internalError "github.com/auth0/terraform-provider-auth0/internal/error"
+	internalSchema "github.com/auth0/terraform-provider-auth0/internal/schema"
 	"github.com/auth0/terraform-provider-auth0/internal/value"
 )
 
 // NewMembersResource will return a new auth0_organization_members (1:many) resource.
 func NewMembersResource() *schema.Resource {
-	return &schema.Resource{
+	return internalSchema.WithIDIdentity(&schema.Resource{
 		Description:   "This resource is used to manage members of an organization.",
 		CreateContext: createOrganizationMembers,
 		ReadContext:   readOrganizationMembers,
@@ -42,7 +43,7 @@ func NewMembersResource() *schema.Resource {
 				Description: "Add user ID(s) directly from the tenant to become members of the organization.",
 			},
 		},
-	}
+	})
 }
 
 func createOrganizationMembers(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -0,0 +1,76 @@
+package organization
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/auth0/terraform-provider-auth0/internal/auth0/commons"
+	internalError "github.com/auth0/terraform-provider-auth0/internal/error"
+)
+
+var resourceSchema = map[string]*schema.Schema{
+	"name": {
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "The name of this organization.",
+	},
+	"display_name": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Friendly name of this organization.",
+	},
+	"third_party_client_access": {
+		Type:         schema.TypeString,
+		Optional:     true,
+		Computed:     true,
+		ValidateFunc: validation.StringInSlice([]string{"allow", "block"}, false),
+		Description: "Controls whether this organization can be used in user flows with third-party clients. " +
+			"Available values are `allow` or `block`. Defaults to `block`.",
+	},
+	"is_app_entitlement_active": {
+		Type:     schema.TypeBool,
+		Optional: true,
+		Computed: true,
+		Description: "Controls whether this organization's app entitlement is active, determining whether " +
+			"members of this organization can access applications associated with it (EA only). This is " +
+			"distinct from `auth0_client_grant`'s `organization_usage` attribute, which controls whether " +
+			"organizations can be used with client credentials exchanges for a given client grant.",
+	},
+	"branding": {
+		Type:        schema.TypeList,
+		Optional:    true,
+		Computed:    true,
+		MaxItems:    1,
+		Description: "Defines how to style the login pages.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"logo_url": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "URL of logo to display on login page.",
+				},
+				"colors": {
+					Type:        schema.TypeMap,
+					Optional:    true,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+					Description: "Color scheme used to customize the login pages.",
+				},
+			},
+		},
+	},
+	"metadata": {
+		Type:        schema.TypeMap,
+		Optional:    true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Description: "Metadata associated with the organization. Maximum of 10 metadata properties allowed.",
+	},
+	"metadata_all": {
+		Type:     schema.TypeMap,
+		Computed: true,
+		Elem:     &schema.Schema{Type: schema.TypeString},
+		Description: "All the metadata of the organization, including the `default_metadata` " +
+			"of the provider.",
+	},
+	"token_quota":    commons.TokenQuotaSchema(),
+	"adopt_existing": internalError.AdoptExistingSchema(),
+}
@@ -11,12 +11,13 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/auth0/terraform-provider-auth0/internal/config"
+	internalSchema "github.com/auth0/terraform-provider-auth0/internal/schema"
 	internalValidation "github.com/auth0/terraform-provider-auth0/internal/validation"
 )
 
 // NewResource will return a new auth0_pages resource.
 func NewResource() *schema.Resource {
-	return &schema.Resource{
+	return internalSchema.WithIDIdentity(&schema.Resource{
 		Description: "With this resource you can manage custom HTML for the " +
 			"Login, Reset Password, Multi-Factor Authentication and Error pages.",
 		CreateContext: createPages,
@@ -122,7 +123,7 @@ func NewResource() *schema.Resource {
 				},
 			},
 		},
-	}
+	})
 }
 
 func createPages(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -9,11 +9,12 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"github.com/auth0/terraform-provider-auth0/internal/config"
+	internalSchema "github.com/auth0/terraform-provider-auth0/internal/schema"
 )
 
 // NewResource will return a new auth0_prompt resource.
 func NewResource() *schema.Resource {
-	return &schema.Resource{
+	return internalSchema.WithIDIdentity(&schema.Resource{
 		CreateContext: createPrompt,
 		ReadContext:   readPrompt,
 		UpdateContext: updatePrompt,
@@ -49,7 +50,7 @@ func NewResource() *schema.Resource {
 					"Setting this property to `true`, requires MFA factors enabled for enrollment; use the `auth0_guardian` resource to set one up.",
 			},
 		},
-	}
+	})
 }
 
 func createPrompt(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
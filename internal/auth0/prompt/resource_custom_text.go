@@ -101,7 +101,7 @@ var (
 
 // NewCustomTextResource will return a new auth0_prompt_custom_text resource.
 func NewCustomTextResource() *schema.Resource {
-	return &schema.Resource{
+	return internalSchema.WithGroupIdentity(&schema.Resource{
 		CreateContext: createPromptCustomText,
 		ReadContext:   readPromptCustomText,
 		UpdateContext: updatePromptCustomText,
@@ -135,7 +135,7 @@ func NewCustomTextResource() *schema.Resource {
 					"[here](https://auth0.com/docs/customize/universal-login-pages/customize-login-text-prompts#prompt-values).",
 			},
 		},
-	}
+	}, "prompt", "language")
 }
 
 func createPromptCustomText(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
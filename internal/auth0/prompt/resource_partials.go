@@ -10,11 +10,12 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"github.com/auth0/terraform-provider-auth0/internal/config"
+	internalSchema "github.com/auth0/terraform-provider-auth0/internal/schema"
 )
 
 // NewPartialsResource creates a new resource for partial prompts.
 func NewPartialsResource() *schema.Resource {
-	return &schema.Resource{
+	return internalSchema.WithIDIdentity(&schema.Resource{
 		CreateContext: createPromptPartials,
 		ReadContext:   readPromptPartials,
 		UpdateContext: updatePromptPartials,
@@ -87,7 +88,7 @@ func NewPartialsResource() *schema.Resource {
 					"for managing a single prompt screen, depending on your use case.",
 			},
 		},
-	}
+	})
 }
 
 // Deprecated: createPromptPartials is deprecated and will be removed in the next major version.
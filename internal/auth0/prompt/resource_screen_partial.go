@@ -12,11 +12,12 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/auth0/terraform-provider-auth0/internal/config"
+	internalSchema "github.com/auth0/terraform-provider-auth0/internal/schema"
 )
 
 // NewScreenPartialResource will return a new auth0_prompt_screen_partial (1:1) resource.
 func NewScreenPartialResource() *schema.Resource {
-	return &schema.Resource{
+	return internalSchema.WithIDIdentity(&schema.Resource{
 		CreateContext: createPromptScreenPartial,
 		ReadContext:   readPromptScreenPartial,
 		UpdateContext: updatePromptScreenPartial,
@@ -85,7 +86,7 @@ func NewScreenPartialResource() *schema.Resource {
 				},
 			},
 		},
-	}
+	})
 }
 
 func createPromptScreenPartial(ctx context.Context, data *schema.ResourceData, meta any) diag.Diagnostics {
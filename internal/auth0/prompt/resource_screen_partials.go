@@ -10,6 +10,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"github.com/auth0/terraform-provider-auth0/internal/config"
+	internalSchema "github.com/auth0/terraform-provider-auth0/internal/schema"
 )
 
 var allowedPromptsWithPartials = []string{
@@ -26,7 +27,7 @@ var allowedPromptsWithPartials = []string{
 
 // NewScreenPartialsResource will return a new auth0_prompt_screen_partials (1:many) resource.
 func NewScreenPartialsResource() *schema.Resource {
-	return &schema.Resource{
+	return internalSchema.WithIDIdentity(&schema.Resource{
 		CreateContext: createPromptScreenPartials,
 		ReadContext:   readPromptScreenPartials,
 		UpdateContext: updatePromptScreenPartials,
@@ -102,7 +103,7 @@ func NewScreenPartialsResource() *schema.Resource {
 				},
 			},
 		},
-	}
+	})
 }
 
 // createPromptScreenPartials creates a new prompt screen partials resource.
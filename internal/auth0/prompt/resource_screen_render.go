@@ -13,6 +13,7 @@ import (
 
 	"github.com/auth0/terraform-provider-auth0/internal/config"
 	internalError "github.com/auth0/terraform-provider-auth0/internal/error"
+	internalSchema "github.com/auth0/terraform-provider-auth0/internal/schema"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -140,7 +141,7 @@ var (
 
 // NewPromptScreenRenderResource will return a new auth0_prompt_screen_renderer resource.
 func NewPromptScreenRenderResource() *schema.Resource {
-	return &schema.Resource{
+	return internalSchema.WithIDIdentity(&schema.Resource{
 		CreateContext: createPromptScreenRenderer,
 		ReadContext:   readPromptScreenRenderer,
 		UpdateContext: updatePromptScreenRenderer,
@@ -245,7 +246,7 @@ func NewPromptScreenRenderResource() *schema.Resource {
 				Description:      "An array of head tags",
 			},
 		},
-	}
+	})
 }
 
 func createPromptScreenRenderer(ctx context.Context, data *schema.ResourceData, meta any) diag.Diagnostics {
@@ -16,7 +16,7 @@ import (
 
 // NewResource will return a new auth0_rate_limit_policy resource.
 func NewResource() *schema.Resource {
-	return &schema.Resource{
+	return internalSchema.WithIDIdentity(&schema.Resource{
 		CreateContext: createRateLimitPolicy,
 		ReadContext:   readRateLimitPolicy,
 		UpdateContext: updateRateLimitPolicy,
@@ -91,7 +91,7 @@ func NewResource() *schema.Resource {
 				Description: "The date and time when the rate limit policy was last updated.",
 			},
 		},
-	}
+	})
 }
 
 // rateLimitPolicyActions returns the valid `action` values, which span multiple SDK union types.
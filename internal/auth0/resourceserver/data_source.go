@@ -22,6 +22,7 @@ func NewDataSource() *schema.Resource {
 
 func dataSourceSchema() map[string]*schema.Schema {
 	dataSourceSchema := internalSchema.TransformResourceToDataSource(internalSchema.Clone(NewResource().Schema))
+	delete(dataSourceSchema, "adopt_existing")
 
 	dataSourceSchema["resource_server_id"] = &schema.Schema{
 		Type:         schema.TypeString,
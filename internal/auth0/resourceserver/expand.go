@@ -19,7 +19,8 @@ func expandResourceServer(data *schema.ResourceData) *management.ResourceServer
 		),
 	}
 
-	if data.IsNewResource() {
+	// Adopted existing resource servers already have an ID, and their identifier can't be updated.
+	if data.IsNewResource() && data.Id() == "" {
 		resourceServer.Identifier = value.String(cfg.GetAttr("identifier"))
 	}
 
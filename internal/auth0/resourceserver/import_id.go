@@ -0,0 +1,32 @@
+package resourceserver
+
+import (
+	"context"
+	"net/url"
+
+	"github.com/auth0/terraform-provider-auth0/internal/config"
+	internalError "github.com/auth0/terraform-provider-auth0/internal/error"
+	internalSchema "github.com/auth0/terraform-provider-auth0/internal/schema"
+)
+
+// ImportIDResolvers resolve the human-readable import IDs of resource servers,
+// e.g. "identifier:https://api.example.com".
+var ImportIDResolvers = internalSchema.ImportIDResolvers{
+	"identifier": resolveResourceServerIDByIdentifier,
+}
+
+func resolveResourceServerIDByIdentifier(ctx context.Context, meta interface{}, identifier string) (string, error) {
+	var ids []string
+
+	// Identifiers are unique, and can be used in place of the ID to read a resource server.
+	resourceServer, err := meta.(*config.Config).GetAPI().ResourceServer.Read(ctx, url.PathEscape(identifier))
+	switch {
+	case internalError.IsStatusNotFound(err):
+	case err != nil:
+		return "", err
+	default:
+		ids = append(ids, resourceServer.GetID())
+	}
+
+	return internalSchema.UniqueImportID("resource server", "identifier", identifier, ids)
+}
@@ -0,0 +1,92 @@
+package resourceserver
+
+import (
+	"context"
+	"strings"
+
+	"github.com/auth0/go-auth0/management"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/auth0/terraform-provider-auth0/internal/config"
+	internalSchema "github.com/auth0/terraform-provider-auth0/internal/schema"
+)
+
+// NewListResource will return a new auth0_resource_server list resource.
+func NewListResource() list.ListResource {
+	return internalSchema.NewListResource(internalSchema.ListResourceConfig{
+		TypeName: "_resource_server",
+		Resource: NewResource,
+		Schema: schema.Schema{
+			MarkdownDescription: "With this list resource, you can find the resource servers (APIs) of the tenant, " +
+				"e.g. to import them with `terraform query`.",
+			Attributes: map[string]schema.Attribute{
+				"name_filter": schema.StringAttribute{
+					Optional:            true,
+					MarkdownDescription: "Filter resource servers by name (partial matches supported).",
+				},
+				"include_system": schema.BoolAttribute{
+					Optional: true,
+					MarkdownDescription: "Whether to also list the resource servers created by Auth0, " +
+						"such as the Auth0 Management API. Defaults to `false`.",
+				},
+			},
+		},
+		List: listResourceServers,
+	})
+}
+
+type listResourceServersModel struct {
+	NameFilter    types.String `tfsdk:"name_filter"`
+	IncludeSystem types.Bool   `tfsdk:"include_system"`
+}
+
+func listResourceServers(
+	ctx context.Context,
+	filters tfsdk.Config,
+	meta interface{},
+	yield func(internalSchema.ListItem) bool,
+) diag.Diagnostics {
+	api := meta.(*config.Config).GetAPI()
+
+	var data listResourceServersModel
+	diagnostics := filters.Get(ctx, &data)
+	if diagnostics.HasError() {
+		return diagnostics
+	}
+
+	nameFilter := data.NameFilter.ValueString()
+	includeSystem := data.IncludeSystem.ValueBool()
+
+	var page int
+	for {
+		resourceServers, err := api.ResourceServer.List(ctx, management.Page(page), management.PerPage(100))
+		if err != nil {
+			diagnostics.AddError("Failed to List Resource Servers", err.Error())
+			return diagnostics
+		}
+
+		for _, resourceServer := range resourceServers.ResourceServers {
+			if resourceServer.GetIsSystem() && !includeSystem {
+				continue
+			}
+
+			if nameFilter != "" && !strings.Contains(resourceServer.GetName(), nameFilter) {
+				continue
+			}
+
+			if !yield(internalSchema.ListItem{ID: resourceServer.GetID(), DisplayName: resourceServer.GetName()}) {
+				return diagnostics
+			}
+		}
+
+		if !resourceServers.HasNext() {
+			return diagnostics
+		}
+
+		page++
+	}
+}
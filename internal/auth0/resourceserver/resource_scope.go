@@ -14,7 +14,7 @@ import (
 
 // NewScopeResource will return a new auth0_connection_client resource.
 func NewScopeResource() *schema.Resource {
-	return &schema.Resource{
+	return internalSchema.WithGroupIdentity(&schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"scope": {
 				Type:        schema.TypeString,
@@ -42,7 +42,7 @@ func NewScopeResource() *schema.Resource {
 			StateContext: internalSchema.ImportResourceGroupID("resource_server_identifier", "scope"),
 		},
 		Description: "With this resource, you can manage scopes (permissions) associated with a resource server (API).",
-	}
+	}, "resource_server_identifier", "scope")
 }
 
 func createResourceServerScope(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -53,8 +53,11 @@ func createResourceServerScope(ctx context.Context, data *schema.ResourceData, m
 	description := data.Get("description").(string)
 
 	mutex := meta.(*config.Config).GetMutex()
-	mutex.Lock(resourceServerIdentifier) // Prevents colliding API requests between other `auth0_resource_server_scope` resource.
-	defer mutex.Unlock(resourceServerIdentifier)
+	ctx, err := mutex.Lock(ctx, resourceServerIdentifier) // Prevents colliding API requests between other `auth0_resource_server_scope` resource.
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	defer mutex.Unlock(ctx, resourceServerIdentifier)
 
 	existingAPI, err := api.ResourceServer.Read(ctx, resourceServerIdentifier)
 	if err != nil {
@@ -92,8 +95,11 @@ func updateResourceServerScope(ctx context.Context, data *schema.ResourceData, m
 	newDescription := data.Get("description").(string)
 
 	mutex := meta.(*config.Config).GetMutex()
-	mutex.Lock(resourceServerIdentifier) // Prevents colliding API requests between other `auth0_resource_server_scope` resource.
-	defer mutex.Unlock(resourceServerIdentifier)
+	ctx, err := mutex.Lock(ctx, resourceServerIdentifier) // Prevents colliding API requests between other `auth0_resource_server_scope` resource.
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	defer mutex.Unlock(ctx, resourceServerIdentifier)
 
 	existingAPI, err := api.ResourceServer.Read(ctx, resourceServerIdentifier)
 	if err != nil {
@@ -156,8 +162,11 @@ func deleteResourceServerScope(ctx context.Context, data *schema.ResourceData, m
 	scope := data.Get("scope").(string)
 
 	mutex := meta.(*config.Config).GetMutex()
-	mutex.Lock(resourceServerIdentifier) // Prevents colliding API requests between other `auth0_resource_server_scope` resource.
-	defer mutex.Unlock(resourceServerIdentifier)
+	ctx, err := mutex.Lock(ctx, resourceServerIdentifier) // Prevents colliding API requests between other `auth0_resource_server_scope` resource.
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	defer mutex.Unlock(ctx, resourceServerIdentifier)
 
 	existingAPI, err := api.ResourceServer.Read(ctx, resourceServerIdentifier)
 	if err != nil {
@@ -11,11 +11,12 @@ import (
 
 	"github.com/auth0/terraform-provider-auth0/internal/config"
 	internalError "github.com/auth0/terraform-provider-auth0/internal/error"
+	internalSchema "github.com/auth0/terraform-provider-auth0/internal/schema"
 )
 
 // NewScopesResource will return a new auth0_resource_server_scopes (1:many) resource.
 func NewScopesResource() *schema.Resource {
-	return &schema.Resource{
+	return internalSchema.WithIDIdentity(&schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"resource_server_identifier": {
 				Type:        schema.TypeString,
@@ -52,7 +53,7 @@ func NewScopesResource() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Description: "With this resource, you can manage scopes (permissions) associated with a resource server (API).",
-	}
+	})
 }
 
 func createResourceServerScopes(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -0,0 +1,337 @@
+package resourceserver
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	internalError "github.com/auth0/terraform-provider-auth0/internal/error"
+)
+
+var resourceSchema = map[string]*schema.Schema{
+	"name": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Computed:    true,
+		Description: "Friendly name for the resource server. Cannot include `<` or `>` characters.",
+	},
+	"identifier": {
+		Type:     schema.TypeString,
+		Required: true,
+		ForceNew: true,
+		Description: "Unique identifier for the resource server. Used as the audience parameter " +
+			"for authorization calls. Cannot be changed once set.",
+	},
+	"signing_alg": {
+		Type:     schema.TypeString,
+		Optional: true,
+		Computed: true,
+		ValidateFunc: validation.StringInSlice([]string{
+			"HS256",
+			"RS256",
+			"PS256",
+		}, true),
+		Description: "Algorithm used to sign JWTs. Options include `HS256`, `RS256`, and `PS256`.",
+	},
+	"signing_secret": {
+		Type:     schema.TypeString,
+		Optional: true,
+		Computed: true,
+		ValidateFunc: func(i interface{}, k string) (s []string, es []error) {
+			v, ok := i.(string)
+			if !ok {
+				es = append(es, fmt.Errorf("expected type of %s to be string", k))
+				return
+			}
+			minLength := 16
+			if len(v) < minLength {
+				es = append(es, fmt.Errorf("expected length of %s to be at least %d, %q is %d", k, minLength, v, len(v)))
+			}
+			return
+		},
+		Description: "Secret used to sign tokens when using symmetric algorithms (HS256).",
+	},
+	"allow_offline_access": {
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Computed:    true,
+		Description: "Indicates whether refresh tokens can be issued for this resource server.",
+	},
+	"allow_online_access": {
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Computed:    true,
+		Description: "Indicates whether Online Refresh Tokens can be issued for this resource server. (EA Only)",
+	},
+	"allow_online_access_with_ephemeral_sessions": {
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Computed:    true,
+		Description: "Indicates whether Online Refresh Tokens can be issued even when sessions are configured as ephemeral. (EA Only)",
+	},
+	"token_lifetime": {
+		Type:     schema.TypeInt,
+		Optional: true,
+		Computed: true,
+		Description: "Number of seconds during which access tokens issued for this resource server " +
+			"from the token endpoint remain valid.",
+	},
+	"token_lifetime_for_web": {
+		Type:     schema.TypeInt,
+		Optional: true,
+		Computed: true,
+		Description: "Number of seconds during which access tokens issued for this resource server via " +
+			"implicit or hybrid flows remain valid. Cannot be greater than the `token_lifetime` value.",
+	},
+	"skip_consent_for_verifiable_first_party_clients": {
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Computed:    true,
+		Description: "Indicates whether to skip user consent for applications flagged as first party.",
+	},
+	"verification_location": {
+		Type:     schema.TypeString,
+		Optional: true,
+		Description: "URL from which to retrieve JWKs for this resource server. " +
+			"Used for verifying the JWT sent to Auth0 for token introspection.",
+	},
+	"enforce_policies": {
+		Type:     schema.TypeBool,
+		Computed: true,
+		Optional: true,
+		Description: "If this setting is enabled, RBAC authorization policies will be enforced for this API. " +
+			"Role and permission assignments will be evaluated during the login transaction.",
+	},
+	"token_dialect": {
+		Type:     schema.TypeString,
+		Optional: true,
+		Computed: true,
+		ValidateFunc: validation.StringInSlice([]string{
+			"access_token",
+			"access_token_authz",
+			"rfc9068_profile",
+			"rfc9068_profile_authz",
+		}, true),
+		Description: "Dialect of access tokens that should be issued for this resource server. " +
+			"Options include `access_token`, `rfc9068_profile`, `access_token_authz`, and `rfc9068_profile_authz`. " +
+			"`access_token` is a JWT containing standard Auth0 claims. `rfc9068_profile` is a JWT conforming to the IETF JWT Access Token Profile. " +
+			"`access_token_authz` is a JWT containing standard Auth0 claims, including RBAC permissions claims. `rfc9068_profile_authz` is a JWT conforming to the IETF JWT Access Token Profile, including RBAC permissions claims. " +
+			"RBAC permissions claims are available if RBAC (`enforce_policies`) is enabled for this API. " +
+			"For more details, refer to [Access Token Profiles](https://auth0.com/docs/secure/tokens/access-tokens/access-token-profiles).",
+	},
+	"consent_policy": {
+		Type:     schema.TypeString,
+		Optional: true,
+		Computed: true,
+		ValidateFunc: validation.StringInSlice([]string{
+			"transactional-authorization-with-mfa",
+			"null",
+		}, true),
+		Description: "Consent policy for this resource server. " +
+			"Options include `transactional-authorization-with-mfa`, or `null` to disable.",
+	},
+	"authorization_details": {
+		Type:        schema.TypeList,
+		Optional:    true,
+		Computed:    true,
+		Description: "Authorization details for this resource server.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"type": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "Type of authorization details.",
+				},
+				"disable": {
+					Type:        schema.TypeBool,
+					Optional:    true,
+					Description: "Disable authorization details.",
+				},
+			},
+		},
+	},
+	"token_encryption": {
+		Type:        schema.TypeList,
+		Optional:    true,
+		Computed:    true,
+		MaxItems:    1,
+		Description: "Configuration for JSON Web Encryption(JWE) of tokens for this resource server.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"format": {
+					Type:     schema.TypeString,
+					Optional: true,
+					Computed: true,
+					ValidateFunc: validation.StringInSlice([]string{
+						"compact-nested-jwe",
+					}, true),
+					RequiredWith: []string{"token_encryption.0.encryption_key"},
+					Description: "Format of the token encryption. " +
+						"Only `compact-nested-jwe` is supported.",
+				},
+				"encryption_key": {
+					Type:         schema.TypeList,
+					Optional:     true,
+					Computed:     true,
+					MaxItems:     1,
+					RequiredWith: []string{"token_encryption.0.format"},
+					Description:  "Authorization details for this resource server.",
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"name": {
+								Type:        schema.TypeString,
+								Optional:    true,
+								Computed:    true,
+								Description: "Name of the encryption key.",
+							},
+							"algorithm": {
+								Type:        schema.TypeString,
+								Required:    true,
+								Description: "Algorithm used to encrypt the token.",
+							},
+							"kid": {
+								Type:        schema.TypeString,
+								Optional:    true,
+								Computed:    true,
+								Description: "Key ID.",
+							},
+							"pem": {
+								Type:        schema.TypeString,
+								Required:    true,
+								Description: "PEM-formatted public key. Must be JSON escaped.",
+							},
+						},
+					},
+				},
+				"disable": {
+					Type:        schema.TypeBool,
+					Optional:    true,
+					Description: "Disable token encryption.",
+				},
+			},
+		},
+	},
+	"proof_of_possession": {
+		Type:        schema.TypeList,
+		Optional:    true,
+		Computed:    true,
+		MaxItems:    1,
+		Description: "Configuration settings for proof-of-possession for this resource server.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"mechanism": {
+					Type:     schema.TypeString,
+					Optional: true,
+					Computed: true,
+					ValidateFunc: validation.StringInSlice([]string{
+						"mtls", "dpop",
+					}, true),
+					Description: "Mechanism used for proof-of-possession. " +
+						"`mtls` or `dpop` is supported.",
+				},
+				"required": {
+					Type:        schema.TypeBool,
+					Optional:    true,
+					Computed:    true,
+					Description: "Indicates whether proof-of-possession is required with this resource server.",
+				},
+				"disable": {
+					Type:        schema.TypeBool,
+					Optional:    true,
+					Description: "Disable proof-of-possession.",
+				},
+				"required_for": {
+					Type:     schema.TypeString,
+					Optional: true,
+					ValidateFunc: validation.StringInSlice([]string{
+						"all_clients", "public_clients",
+					}, true),
+					Description: "Specifies which client types require Proof-of-Possession" +
+						"`all_clients` or `public_clients` is supported.",
+				},
+			},
+		},
+	},
+	"authorization_policy": {
+		Type:        schema.TypeList,
+		Optional:    true,
+		Computed:    true,
+		MaxItems:    1,
+		Description: "Authorization policy for the resource server.(EA Only)",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"policy_id": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Computed:    true,
+					Description: "Identifier of the authorization policy.",
+				},
+			},
+		},
+	},
+	"subject_type_authorization": {
+		Type:        schema.TypeList,
+		Optional:    true,
+		Computed:    true,
+		MaxItems:    1,
+		Description: "Authorization policies for user and client flows.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"user": {
+					Type:        schema.TypeList,
+					Optional:    true,
+					Computed:    true,
+					MaxItems:    1,
+					Description: "User authorization policies for the resource server.",
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"policy": {
+								Type:     schema.TypeString,
+								Optional: true,
+								ValidateFunc: validation.StringInSlice([]string{
+									"allow_all",
+									"deny_all",
+									"require_client_grant",
+								}, false),
+								Description: "User flows policy. One of `allow_all`, `deny_all`, `require_client_grant`.",
+							},
+						},
+					},
+				},
+				"client": {
+					Type:        schema.TypeList,
+					Optional:    true,
+					Computed:    true,
+					MaxItems:    1,
+					Description: "Client authorization policies for the resource server.",
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"policy": {
+								Type:     schema.TypeString,
+								Optional: true,
+								ValidateFunc: validation.StringInSlice([]string{
+									"deny_all",
+									"require_client_grant",
+								}, false),
+								Description: "Client flows policy. One of `deny_all`, `require_client_grant`.",
+							},
+						},
+					},
+				},
+			},
+		},
+	},
+	"client_id": {
+		Type:     schema.TypeString,
+		Computed: true,
+		Description: "The ID of the client associated with this resource server. If a client has been created " +
+			"and linked to this resource server, this field will be populated with that client's ID.",
+	},
+	"is_system": {
+		Type:        schema.TypeBool,
+		Computed:    true,
+		Description: "Indicates whether this resource server is a special resource server created by Auth0. It cannot be modified or deleted directly.",
+	},
+	"adopt_existing": internalError.AdoptExistingSchema(),
+}
@@ -3,6 +3,7 @@ package riskassessment
 import (
 	"context"
 
+	internalSchema "github.com/auth0/terraform-provider-auth0/internal/schema"
 	"github.com/auth0/terraform-provider-auth0/internal/value"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -16,7 +17,7 @@ import (
 
 // NewResource will return a new auth0_risk_assessments resource.
 func NewResource() *schema.Resource {
-	return &schema.Resource{
+	return internalSchema.WithIDIdentity(&schema.Resource{
 		ReadContext:   readRiskAssessmentSettings,
 		CreateContext: createRiskAssessmentSettings,
 		UpdateContext: updateRiskAssessmentSettings,
@@ -33,7 +34,7 @@ func NewResource() *schema.Resource {
 			},
 		},
 		Description: "Resource for managing general Risk Assessment settings.",
-	}
+	})
 }
 
 func createRiskAssessmentSettings(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -8,6 +8,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"github.com/auth0/terraform-provider-auth0/internal/config"
+	internalSchema "github.com/auth0/terraform-provider-auth0/internal/schema"
 	"github.com/auth0/terraform-provider-auth0/internal/value"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -18,7 +19,7 @@ import (
 
 // NewDeviceSettingResource will return a new auth0_risk_assessments_new_device resource.
 func NewDeviceSettingResource() *schema.Resource {
-	return &schema.Resource{
+	return internalSchema.WithIDIdentity(&schema.Resource{
 		ReadContext:   readRiskAssessmentNewDeviceSettings,
 		CreateContext: createRiskAssessmentNewDeviceSettings,
 		UpdateContext: updateRiskAssessmentNewDeviceSettings,
@@ -36,7 +37,7 @@ func NewDeviceSettingResource() *schema.Resource {
 			},
 		},
 		Description: "Resource for managing Risk Assessment settings for new devices.",
-	}
+	})
 }
 
 func createRiskAssessmentNewDeviceSettings(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
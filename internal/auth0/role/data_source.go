@@ -22,6 +22,8 @@ func NewDataSource() *schema.Resource {
 
 func dataSourceSchema() map[string]*schema.Schema {
 	dataSourceSchema := internalSchema.TransformResourceToDataSource(NewResource().Schema)
+	delete(dataSourceSchema, "adopt_existing")
+
 	dataSourceSchema["role_id"] = &schema.Schema{
 		Type:         schema.TypeString,
 		Optional:     true,
@@ -165,6 +167,50 @@ func readRoleByName(
 	api *management.Management,
 	roleName string,
 ) diag.Diagnostics {
+	roles, err := findRolesByName(ctx, api, roleName, 1, roleListFilters(data)...)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if len(roles) == 0 {
+		return diag.Errorf("No role found with \"name\" = %q", roleName)
+	}
+
+	role := roles[0]
+	data.SetId(role.GetID())
+
+	var permissions []*management.Permission
+	skipPermissions := data.Get("skip_permissions").(bool)
+	if !skipPermissions {
+		permissions, err = getAllRolePermissions(ctx, api, role.GetID())
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	var users []*management.User
+	skipUsers := data.Get("skip_users").(bool)
+	if !skipUsers {
+		users, err = getAllRoleUsers(ctx, api, role.GetID())
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return diag.FromErr(flattenRoleForDataSource(data, role, permissions, users))
+}
+
+// findRolesByName returns the roles with the given name, stopping once
+// limit roles are found. A limit of 0 returns all the roles.
+func findRolesByName(
+	ctx context.Context,
+	api *management.Management,
+	roleName string,
+	limit int,
+	filters ...management.RequestOption,
+) ([]*management.Role, error) {
+	var found []*management.Role
+
 	page := 0
 	for {
 		options := []management.RequestOption{
@@ -172,47 +218,28 @@ func readRoleByName(
 			management.PerPage(100),
 			management.Parameter("name_filter", roleName),
 		}
-		options = append(options, roleListFilters(data)...)
+		options = append(options, filters...)
 
 		roles, err := api.Role.List(ctx, options...)
 		if err != nil {
-			return diag.FromErr(err)
+			return nil, err
 		}
 
 		for _, role := range roles.Roles {
 			if role.GetName() == roleName {
-				data.SetId(role.GetID())
-
-				var permissions []*management.Permission
-				skipPermissions := data.Get("skip_permissions").(bool)
-				if !skipPermissions {
-					permissions, err = getAllRolePermissions(ctx, api, role.GetID())
-					if err != nil {
-						return diag.FromErr(err)
-					}
+				found = append(found, role)
+				if len(found) == limit {
+					return found, nil
 				}
-
-				var users []*management.User
-				skipUsers := data.Get("skip_users").(bool)
-				if !skipUsers {
-					users, err = getAllRoleUsers(ctx, api, role.GetID())
-					if err != nil {
-						return diag.FromErr(err)
-					}
-				}
-
-				return diag.FromErr(flattenRoleForDataSource(data, role, permissions, users))
 			}
 		}
 
 		if !roles.HasNext() {
-			break
+			return found, nil
 		}
 
 		page++
 	}
-
-	return diag.Errorf("No role found with \"name\" = %q", roleName)
 }
 
 func getAllRolePermissions(
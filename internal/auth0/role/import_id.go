@@ -0,0 +1,27 @@
+package role
+
+import (
+	"context"
+
+	"github.com/auth0/terraform-provider-auth0/internal/config"
+	internalSchema "github.com/auth0/terraform-provider-auth0/internal/schema"
+)
+
+// ImportIDResolvers resolve the human-readable import IDs of roles, e.g. "name:Admin".
+var ImportIDResolvers = internalSchema.ImportIDResolvers{
+	"name": resolveRoleIDByName,
+}
+
+func resolveRoleIDByName(ctx context.Context, meta interface{}, name string) (string, error) {
+	roles, err := findRolesByName(ctx, meta.(*config.Config).GetAPI(), name, 0)
+	if err != nil {
+		return "", err
+	}
+
+	ids := make([]string, 0, len(roles))
+	for _, role := range roles {
+		ids = append(ids, role.GetID())
+	}
+
+	return internalSchema.UniqueImportID("role", "name", name, ids)
+}
@@ -0,0 +1,98 @@
+package role
+
+import (
+	"context"
+
+	"github.com/auth0/go-auth0/management"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/auth0/terraform-provider-auth0/internal/config"
+	internalSchema "github.com/auth0/terraform-provider-auth0/internal/schema"
+)
+
+// NewListResource will return a new auth0_role list resource.
+func NewListResource() list.ListResource {
+	return internalSchema.NewListResource(internalSchema.ListResourceConfig{
+		TypeName: "_role",
+		Resource: NewResource,
+		Schema: schema.Schema{
+			MarkdownDescription: "With this list resource, you can find the roles of the tenant, " +
+				"e.g. to import them with `terraform query`.",
+			Attributes: map[string]schema.Attribute{
+				"name_filter": schema.StringAttribute{
+					Optional:            true,
+					MarkdownDescription: "Filter roles by name (partial matches supported).",
+				},
+				"type": schema.StringAttribute{
+					Optional:            true,
+					MarkdownDescription: "Filter roles by type, either `tenant` or `organization`.",
+				},
+				"owner_id": schema.StringAttribute{
+					Optional:            true,
+					MarkdownDescription: "Filter roles by the ID of the organization owning them.",
+				},
+			},
+		},
+		List: listRoles,
+	})
+}
+
+type listRolesModel struct {
+	NameFilter types.String `tfsdk:"name_filter"`
+	Type       types.String `tfsdk:"type"`
+	OwnerID    types.String `tfsdk:"owner_id"`
+}
+
+func listRoles(
+	ctx context.Context,
+	filters tfsdk.Config,
+	meta interface{},
+	yield func(internalSchema.ListItem) bool,
+) diag.Diagnostics {
+	api := meta.(*config.Config).GetAPI()
+
+	var data listRolesModel
+	diagnostics := filters.Get(ctx, &data)
+	if diagnostics.HasError() {
+		return diagnostics
+	}
+
+	params := []management.RequestOption{
+		management.PerPage(100),
+	}
+
+	if nameFilter := data.NameFilter.ValueString(); nameFilter != "" {
+		params = append(params, management.Parameter("name_filter", nameFilter))
+	}
+	if roleType := data.Type.ValueString(); roleType != "" {
+		params = append(params, management.Parameter("type", roleType))
+	}
+	if ownerID := data.OwnerID.ValueString(); ownerID != "" {
+		params = append(params, management.Parameter("owner_id", ownerID))
+	}
+
+	var page int
+	for {
+		roles, err := api.Role.List(ctx, append(params, management.Page(page))...)
+		if err != nil {
+			diagnostics.AddError("Failed to List Roles", err.Error())
+			return diagnostics
+		}
+
+		for _, role := range roles.Roles {
+			if !yield(internalSchema.ListItem{ID: role.GetID(), DisplayName: role.GetName()}) {
+				return diagnostics
+			}
+		}
+
+		if !roles.HasNext() {
+			return diagnostics
+		}
+
+		page++
+	}
+}
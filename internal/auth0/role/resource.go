@@ -4,23 +4,25 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/auth0/go-auth0/management"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"github.com/auth0/terraform-provider-auth0/internal/config"
 	internalError "github.com/auth0/terraform-provider-auth0/internal/error"
+	internalSchema "github.com/auth0/terraform-provider-auth0/internal/schema"
 )
 
 // NewResource will return a new auth0_role resource.
 func NewResource() *schema.Resource {
-	return &schema.Resource{
+	return internalSchema.WithIDIdentity(&schema.Resource{
 		CreateContext: createRole,
 		UpdateContext: updateRole,
 		ReadContext:   readRole,
 		DeleteContext: deleteRole,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: internalSchema.ImportStateResolvingID(ImportIDResolvers, schema.ImportStatePassthroughContext),
 		},
 		CustomizeDiff: validateRole,
 		Description: "With this resource, you can create and manage collections of permissions that can be " +
@@ -57,8 +59,9 @@ func NewResource() *schema.Resource {
 					"`organization`, and required in that case. The Management API only accepts this " +
 					"field on creation, so changing it forces a new role to be created. (EA only)",
 			},
+			"adopt_existing": internalError.AdoptExistingSchema(),
 		},
-	}
+	})
 }
 
 func validateRole(_ context.Context, data *schema.ResourceDiff, _ interface{}) error {
@@ -100,7 +103,25 @@ func createRole(ctx context.Context, data *schema.ResourceData, meta interface{}
 	role := expandRole(data)
 
 	if err := api.Role.Create(ctx, role); err != nil {
-		return diag.FromErr(err)
+		diagnostics, adopted := internalError.AdoptExisting(
+			ctx,
+			"auth0_role",
+			fmt.Sprintf("name %q", role.GetName()),
+			data,
+			err,
+			internalError.AdoptExistingEnabled(data, meta.(*config.Config).GetAdoptExisting()),
+			func(ctx context.Context) (string, error) {
+				return findAdoptableRoleID(ctx, api, role.GetName(), data.Get("type").(string), data.Get("owner_id").(string))
+			},
+		)
+		if !adopted {
+			return diag.FromErr(err)
+		}
+		if diagnostics.HasError() {
+			return diagnostics
+		}
+
+		return append(diagnostics, updateRole(ctx, data, meta)...)
 	}
 
 	data.SetId(role.GetID())
@@ -140,3 +161,55 @@ func deleteRole(ctx context.Context, data *schema.ResourceData, meta interface{}
 
 	return nil
 }
+
+// findAdoptableRoleID returns the ID of the role with the given name, type and
+// owner, failing if the role with that name has another type or owner, as they
+// can't be updated once the role is created. Organization roles of different
+// owners can share the same name.
+func findAdoptableRoleID(
+	ctx context.Context,
+	api *management.Management,
+	name string,
+	roleType string,
+	ownerID string,
+) (string, error) {
+	roles, err := findRolesByName(ctx, api, name, 0)
+	if err != nil {
+		return "", err
+	}
+
+	var ids []string
+	var mismatch error
+	for _, role := range roles {
+		if err := internalError.CheckAdoptableAttribute(
+			"type",
+			roleTypeOrDefault(role.GetType()),
+			roleTypeOrDefault(roleType),
+		); err != nil {
+			mismatch = err
+			continue
+		}
+
+		if err := internalError.CheckAdoptableAttribute("owner_id", role.GetOwnerID(), ownerID); err != nil {
+			mismatch = err
+			continue
+		}
+
+		ids = append(ids, role.GetID())
+	}
+
+	if len(ids) == 0 && mismatch != nil {
+		return "", mismatch
+	}
+
+	return internalSchema.UniqueImportID("role", "name", name, ids)
+}
+
+// roleTypeOrDefault returns the type of a role, which is tenant when unset.
+func roleTypeOrDefault(roleType string) string {
+	if roleType == "" {
+		return "tenant"
+	}
+
+	return roleType
+}
@@ -14,7 +14,7 @@ import (
 
 // NewPermissionResource will return a new auth0_role_permission resource.
 func NewPermissionResource() *schema.Resource {
-	return &schema.Resource{
+	return internalSchema.WithGroupIdentity(&schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"role_id": {
 				Type:        schema.TypeString,
@@ -49,10 +49,17 @@ func NewPermissionResource() *schema.Resource {
 		ReadContext:   readRolePermission,
 		DeleteContext: deleteRolePermission,
 		Importer: &schema.ResourceImporter{
-			StateContext: internalSchema.ImportResourceGroupID("role_id", "resource_server_identifier", "permission"),
+			StateContext: internalSchema.ImportResourceGroupIDResolving(
+				map[string]internalSchema.ImportIDResolvers{
+					"role_id": ImportIDResolvers,
+				},
+				"role_id",
+				"resource_server_identifier",
+				"permission",
+			),
 		},
 		Description: "With this resource, you can manage role permissions (1-1).",
-	}
+	}, "role_id", "resource_server_identifier", "permission")
 }
 
 func createRolePermission(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
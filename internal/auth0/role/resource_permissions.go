@@ -10,12 +10,13 @@ import (
 
 	"github.com/auth0/terraform-provider-auth0/internal/config"
 	internalError "github.com/auth0/terraform-provider-auth0/internal/error"
+	internalSchema "github.com/auth0/terraform-provider-auth0/internal/schema"
 	"github.com/auth0/terraform-provider-auth0/internal/value"
 )
 
 // NewPermissionsResource will return a new auth0_role_permissions (1:many) resource.
 func NewPermissionsResource() *schema.Resource {
-	return &schema.Resource{
+	return internalSchema.WithIDIdentity(&schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"role_id": {
 				Type:        schema.TypeString,
@@ -61,7 +62,7 @@ func NewPermissionsResource() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Description: "With this resource, you can manage role permissions (1-many).",
-	}
+	})
 }
 
 func upsertRolePermissions(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
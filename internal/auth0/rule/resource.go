@@ -10,13 +10,14 @@ import (
 
 	"github.com/auth0/terraform-provider-auth0/internal/config"
 	internalError "github.com/auth0/terraform-provider-auth0/internal/error"
+	internalSchema "github.com/auth0/terraform-provider-auth0/internal/schema"
 )
 
 var ruleNameRegexp = regexp.MustCompile(`^[^\s-][\w -]+[^\s-]$`)
 
 // NewResource will return a new auth0_rule resource.
 func NewResource() *schema.Resource {
-	return &schema.Resource{
+	return internalSchema.WithIDIdentity(&schema.Resource{
 		DeprecationMessage: "This resource is deprecated. Refer to the [guide on how to migrate from rules to actions](https://auth0.com/docs/customize/actions/migrate/migrate-from-rules-to-actions) " +
 			"and manage your actions using the `auth0_action` resource.",
 		CreateContext: createRule,
@@ -62,7 +63,7 @@ func NewResource() *schema.Resource {
 				Description: "Indicates whether the rule is enabled.",
 			},
 		},
-	}
+	})
 }
 
 func createRule(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -8,11 +8,12 @@ import (
 
 	"github.com/auth0/terraform-provider-auth0/internal/config"
 	internalError "github.com/auth0/terraform-provider-auth0/internal/error"
+	internalSchema "github.com/auth0/terraform-provider-auth0/internal/schema"
 )
 
 // NewConfigResource will return a new auth0_rule_config resource.
 func NewConfigResource() *schema.Resource {
-	return &schema.Resource{
+	return internalSchema.WithIDIdentity(&schema.Resource{
 		CreateContext: createRuleConfig,
 		ReadContext:   readRuleConfig,
 		UpdateContext: updateRuleConfig,
@@ -38,7 +39,7 @@ func NewConfigResource() *schema.Resource {
 				Description: "Value for a rules configuration variable.",
 			},
 		},
-	}
+	})
 }
 
 func createRuleConfig(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -13,6 +13,7 @@ import (
 
 	"github.com/auth0/terraform-provider-auth0/internal/config"
 	internalError "github.com/auth0/terraform-provider-auth0/internal/error"
+	internalSchema "github.com/auth0/terraform-provider-auth0/internal/schema"
 )
 
 var (
@@ -21,7 +22,7 @@ var (
 
 // NewResource will return a new auth0_self_service_profile resource.
 func NewResource() *schema.Resource {
-	return &schema.Resource{
+	return internalSchema.WithIDIdentity(&schema.Resource{
 		CreateContext: createSelfServiceProfile,
 		ReadContext:   readSelfServiceProfile,
 		UpdateContext: updateSelfServiceProfile,
@@ -134,7 +135,7 @@ func NewResource() *schema.Resource {
 				Description: "The ISO 8601 formatted date the profile was updated.",
 			},
 		},
-	}
+	})
 }
 
 func fixMutuallyExclusiveFields(ctx context.Context, data *schema.ResourceData, api *management.Management) error {
@@ -16,7 +16,7 @@ import (
 
 // NewCustomTextResource will return a new auth0_self_service_profile_custom_text resource.
 func NewCustomTextResource() *schema.Resource {
-	return &schema.Resource{
+	return internalSchema.WithGroupIdentity(&schema.Resource{
 		CreateContext: createCustomTextForSSOProfile,
 		ReadContext:   readCustomTextForSSOProfile,
 		UpdateContext: updateCustomTextForSSOProfile,
@@ -53,7 +53,7 @@ func NewCustomTextResource() *schema.Resource {
 					"Values can be plain text or rich HTML content limited to basic styling tags and hyperlinks",
 			},
 		},
-	}
+	}, "sso_id", "language", "page")
 }
 
 func createCustomTextForSSOProfile(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -8,11 +8,12 @@ import (
 
 	"github.com/auth0/terraform-provider-auth0/internal/config"
 	internalError "github.com/auth0/terraform-provider-auth0/internal/error"
+	internalSchema "github.com/auth0/terraform-provider-auth0/internal/schema"
 )
 
 // NewResource will return a new auth0_supplemental_signals resource.
 func NewResource() *schema.Resource {
-	return &schema.Resource{
+	return internalSchema.WithIDIdentity(&schema.Resource{
 		CreateContext: createSupplementalSignals,
 		UpdateContext: updateSupplementalSignals,
 		ReadContext:   readSupplementalSignals,
@@ -29,7 +30,7 @@ func NewResource() *schema.Resource {
 				Description: "Indicates if incoming Akamai Headers should be processed.",
 			},
 		},
-	}
+	})
 }
 
 func createSupplementalSignals(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -40,8 +41,11 @@ func createSupplementalSignals(ctx context.Context, data *schema.ResourceData, m
 
 func updateSupplementalSignals(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	mutex := meta.(*config.Config).GetMutex()
-	mutex.Lock("supplemental_signals")
-	defer mutex.Unlock("supplemental_signals")
+	ctx, err := mutex.Lock(ctx, "supplemental_signals")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	defer mutex.Unlock(ctx, "supplemental_signals")
 
 	apiv3 := meta.(*config.Config).GetAPIV3()
 
@@ -69,8 +73,11 @@ func readSupplementalSignals(ctx context.Context, data *schema.ResourceData, met
 
 func deleteSupplementalSignals(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	mutex := meta.(*config.Config).GetMutex()
-	mutex.Lock("supplemental_signals")
-	defer mutex.Unlock("supplemental_signals")
+	ctx, err := mutex.Lock(ctx, "supplemental_signals")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	defer mutex.Unlock(ctx, "supplemental_signals")
 
 	apiv3 := meta.(*config.Config).GetAPIV3()
 
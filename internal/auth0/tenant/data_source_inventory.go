@@ -0,0 +1,483 @@
+package tenant
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/auth0/go-auth0/management"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"golang.org/x/sync/errgroup"
+
+	"github.com/auth0/terraform-provider-auth0/internal/config"
+)
+
+const inventoryPageSize = 100
+
+// inventoryObject is an object of the tenant found by the inventory.
+type inventoryObject struct {
+	ID   string
+	Name string
+	Type string
+}
+
+// inventoryLister lists all the objects of one type, making its
+// requests to the Management API through the given inventory.
+type inventoryLister func(ctx context.Context, inventory *inventory) ([]inventoryObject, error)
+
+var inventoryListers = map[string]inventoryLister{
+	"action":          listActionObjects,
+	"client":          listClientObjects,
+	"connection":      listConnectionObjects,
+	"custom_domain":   listCustomDomainObjects,
+	"event_stream":    listEventStreamObjects,
+	"log_stream":      listLogStreamObjects,
+	"network_acl":     listNetworkACLObjects,
+	"organization":    listOrganizationObjects,
+	"resource_server": listResourceServerObjects,
+	"role":            listRoleObjects,
+}
+
+// inventoryTypes holds the object types the inventory knows how to list, sorted.
+var inventoryTypes = func() []string {
+	types := make([]string, 0, len(inventoryListers))
+	for objectType := range inventoryListers {
+		types = append(types, objectType)
+	}
+	sort.Strings(types)
+
+	return types
+}()
+
+// NewInventoryDataSource will return a new auth0_tenant_inventory data source.
+func NewInventoryDataSource() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: readInventoryForDataSource,
+		Description: "Use this data source to list the IDs, names and types of the objects of the tenant. " +
+			"Combined with `terraform state list`, it helps finding the objects that are not managed by Terraform, " +
+			"e.g. clients created by hand in the dashboard.",
+		Schema: map[string]*schema.Schema{
+			"types": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(inventoryTypes, false),
+				},
+				Description: "Only list the objects of these types. Defaults to all types. Options include: " +
+					"`" + strings.Join(inventoryTypes, "`, `") + "`.",
+			},
+			"parallelism": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      4,
+				ValidateFunc: validation.IntBetween(1, 16),
+				Description:  "The maximum number of concurrent requests made to the Management API. Defaults to `4`.",
+			},
+			"objects": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The objects of the tenant, sorted by type, name and ID.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the object, as used to import it.",
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+							Description: "The name of the object. This is the domain of custom domains " +
+								"and the description of network ACLs.",
+						},
+						"type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The type of the object, e.g. `client`.",
+						},
+						"resource_type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The type of the resource managing the object, e.g. `auth0_client`.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func readInventoryForDataSource(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	objectTypes := inventoryTypes
+	if types := data.Get("types").(*schema.Set); types.Len() > 0 {
+		objectTypes = make([]string, 0, types.Len())
+		for _, objectType := range types.List() {
+			objectTypes = append(objectTypes, objectType.(string))
+		}
+		sort.Strings(objectTypes)
+	}
+
+	inventory := newInventory(meta.(*config.Config).GetAPI(), data.Get("parallelism").(int))
+
+	objects, err := inventory.list(ctx, objectTypes)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	data.SetId(generateInventoryID(objectTypes))
+
+	return diag.FromErr(data.Set("objects", flattenInventoryObjects(objects)))
+}
+
+func generateInventoryID(objectTypes []string) string {
+	h := sha256.New()
+	_, _ = fmt.Fprintf(h, "%v", objectTypes)
+	return fmt.Sprintf("tenant-inventory-%x", h.Sum(nil))
+}
+
+func flattenInventoryObjects(objects []inventoryObject) []interface{} {
+	result := make([]interface{}, 0, len(objects))
+	for _, object := range objects {
+		result = append(result, map[string]interface{}{
+			"id":            object.ID,
+			"name":          object.Name,
+			"type":          object.Type,
+			"resource_type": "auth0_" + object.Type,
+		})
+	}
+
+	return result
+}
+
+// inventory lists the objects of the tenant, bounding the
+// number of concurrent requests made to the Management API.
+type inventory struct {
+	api      *management.Management
+	requests chan struct{}
+}
+
+func newInventory(api *management.Management, parallelism int) *inventory {
+	return &inventory{
+		api:      api,
+		requests: make(chan struct{}, parallelism),
+	}
+}
+
+// request runs the given request once fewer than parallelism requests are in flight.
+func (i *inventory) request(ctx context.Context, request func() error) error {
+	select {
+	case i.requests <- struct{}{}:
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+	defer func() { <-i.requests }()
+
+	return request()
+}
+
+// list lists the objects of the given types concurrently, sorted by type, name and ID.
+func (i *inventory) list(ctx context.Context, objectTypes []string) ([]inventoryObject, error) {
+	results := make([][]inventoryObject, len(objectTypes))
+
+	group, groupCtx := errgroup.WithContext(ctx)
+	for index, objectType := range objectTypes {
+		group.Go(func() error {
+			objects, err := inventoryListers[objectType](groupCtx, i)
+			if err != nil {
+				return fmt.Errorf("failed to list the %s objects: %w", objectType, err)
+			}
+
+			for objectIndex := range objects {
+				objects[objectIndex].Type = objectType
+			}
+			results[index] = objects
+
+			return nil
+		})
+	}
+
+	if err := group.Wait(); err != nil {
+		return nil, err
+	}
+
+	var objects []inventoryObject
+	for _, result := range results {
+		objects = append(objects, result...)
+	}
+
+	sort.SliceStable(objects, func(a, b int) bool {
+		if objects[a].Type != objects[b].Type {
+			return objects[a].Type < objects[b].Type
+		}
+		if objects[a].Name != objects[b].Name {
+			return objects[a].Name < objects[b].Name
+		}
+		return objects[a].ID < objects[b].ID
+	})
+
+	return objects, nil
+}
+
+// pageFetcher fetches the objects of one page of a page based endpoint.
+type pageFetcher func(ctx context.Context, page int) ([]inventoryObject, management.List, error)
+
+// fetchPages fetches the first page to learn the total number
+// of objects, then fetches the remaining pages concurrently.
+func (i *inventory) fetchPages(ctx context.Context, fetchPage pageFetcher) ([]inventoryObject, error) {
+	var firstPage []inventoryObject
+	var list management.List
+	if err := i.request(ctx, func() (err error) {
+		firstPage, list, err = fetchPage(ctx, 0)
+		return err
+	}); err != nil {
+		return nil, err
+	}
+
+	if !list.HasNext() {
+		return firstPage, nil
+	}
+
+	if list.Limit <= 0 {
+		list.Limit = inventoryPageSize
+	}
+
+	pageCount := (list.Total + list.Limit - 1) / list.Limit
+	pages := make([][]inventoryObject, pageCount)
+	pages[0] = firstPage
+
+	group, groupCtx := errgroup.WithContext(ctx)
+	for page := 1; page < pageCount; page++ {
+		group.Go(func() error {
+			return i.request(groupCtx, func() (err error) {
+				pages[page], _, err = fetchPage(groupCtx, page)
+				return err
+			})
+		})
+	}
+
+	if err := group.Wait(); err != nil {
+		return nil, err
+	}
+
+	var objects []inventoryObject
+	for _, page := range pages {
+		objects = append(objects, page...)
+	}
+
+	return objects, nil
+}
+
+// checkpointFetcher fetches the objects of one page of a checkpoint based endpoint.
+type checkpointFetcher func(ctx context.Context, from string) ([]inventoryObject, management.List, error)
+
+// fetchCheckpoints fetches the pages one after the other, as
+// each page holds the checkpoint of the next one.
+func (i *inventory) fetchCheckpoints(ctx context.Context, fetchPage checkpointFetcher) ([]inventoryObject, error) {
+	var objects []inventoryObject
+	var from string
+
+	for {
+		var page []inventoryObject
+		var list management.List
+		if err := i.request(ctx, func() (err error) {
+			page, list, err = fetchPage(ctx, from)
+			return err
+		}); err != nil {
+			return nil, err
+		}
+
+		objects = append(objects, page...)
+
+		if !list.HasNext() {
+			return objects, nil
+		}
+
+		from = list.Next
+	}
+}
+
+func checkpointOptions(from string) []management.RequestOption {
+	options := []management.RequestOption{management.Take(inventoryPageSize)}
+	if from != "" {
+		options = append(options, management.From(from))
+	}
+
+	return options
+}
+
+func listClientObjects(ctx context.Context, inventory *inventory) ([]inventoryObject, error) {
+	return inventory.fetchPages(ctx, func(ctx context.Context, page int) ([]inventoryObject, management.List, error) {
+		clients, err := inventory.api.Client.List(
+			ctx,
+			management.Page(page),
+			management.PerPage(inventoryPageSize),
+			management.IncludeFields("client_id", "name"),
+		)
+		if err != nil {
+			return nil, management.List{}, err
+		}
+
+		objects := make([]inventoryObject, 0, len(clients.Clients))
+		for _, client := range clients.Clients {
+			objects = append(objects, inventoryObject{ID: client.GetClientID(), Name: client.GetName()})
+		}
+
+		return objects, clients.List, nil
+	})
+}
+
+func listResourceServerObjects(ctx context.Context, inventory *inventory) ([]inventoryObject, error) {
+	return inventory.fetchPages(ctx, func(ctx context.Context, page int) ([]inventoryObject, management.List, error) {
+		resourceServers, err := inventory.api.ResourceServer.List(
+			ctx,
+			management.Page(page),
+			management.PerPage(inventoryPageSize),
+		)
+		if err != nil {
+			return nil, management.List{}, err
+		}
+
+		objects := make([]inventoryObject, 0, len(resourceServers.ResourceServers))
+		for _, resourceServer := range resourceServers.ResourceServers {
+			objects = append(objects, inventoryObject{ID: resourceServer.GetID(), Name: resourceServer.GetName()})
+		}
+
+		return objects, resourceServers.List, nil
+	})
+}
+
+func listRoleObjects(ctx context.Context, inventory *inventory) ([]inventoryObject, error) {
+	return inventory.fetchPages(ctx, func(ctx context.Context, page int) ([]inventoryObject, management.List, error) {
+		roles, err := inventory.api.Role.List(ctx, management.Page(page), management.PerPage(inventoryPageSize))
+		if err != nil {
+			return nil, management.List{}, err
+		}
+
+		objects := make([]inventoryObject, 0, len(roles.Roles))
+		for _, role := range roles.Roles {
+			objects = append(objects, inventoryObject{ID: role.GetID(), Name: role.GetName()})
+		}
+
+		return objects, roles.List, nil
+	})
+}
+
+func listActionObjects(ctx context.Context, inventory *inventory) ([]inventoryObject, error) {
+	return inventory.fetchPages(ctx, func(ctx context.Context, page int) ([]inventoryObject, management.List, error) {
+		actions, err := inventory.api.Action.List(ctx, management.Page(page), management.PerPage(inventoryPageSize))
+		if err != nil {
+			return nil, management.List{}, err
+		}
+
+		objects := make([]inventoryObject, 0, len(actions.Actions))
+		for _, action := range actions.Actions {
+			objects = append(objects, inventoryObject{ID: action.GetID(), Name: action.GetName()})
+		}
+
+		return objects, actions.List, nil
+	})
+}
+
+func listConnectionObjects(ctx context.Context, inventory *inventory) ([]inventoryObject, error) {
+	return inventory.fetchCheckpoints(ctx, func(ctx context.Context, from string) ([]inventoryObject, management.List, error) {
+		connections, err := inventory.api.Connection.List(ctx, checkpointOptions(from)...)
+		if err != nil {
+			return nil, management.List{}, err
+		}
+
+		objects := make([]inventoryObject, 0, len(connections.Connections))
+		for _, connection := range connections.Connections {
+			objects = append(objects, inventoryObject{ID: connection.GetID(), Name: connection.GetName()})
+		}
+
+		return objects, connections.List, nil
+	})
+}
+
+func listOrganizationObjects(ctx context.Context, inventory *inventory) ([]inventoryObject, error) {
+	return inventory.fetchCheckpoints(ctx, func(ctx context.Context, from string) ([]inventoryObject, management.List, error) {
+		organizations, err := inventory.api.Organization.List(ctx, checkpointOptions(from)...)
+		if err != nil {
+			return nil, management.List{}, err
+		}
+
+		objects := make([]inventoryObject, 0, len(organizations.Organizations))
+		for _, organization := range organizations.Organizations {
+			objects = append(objects, inventoryObject{ID: organization.GetID(), Name: organization.GetName()})
+		}
+
+		return objects, organizations.List, nil
+	})
+}
+
+func listEventStreamObjects(ctx context.Context, inventory *inventory) ([]inventoryObject, error) {
+	return inventory.fetchCheckpoints(ctx, func(ctx context.Context, from string) ([]inventoryObject, management.List, error) {
+		eventStreams, err := inventory.api.EventStream.List(ctx, checkpointOptions(from)...)
+		if err != nil {
+			return nil, management.List{}, err
+		}
+
+		objects := make([]inventoryObject, 0, len(eventStreams.EventStreams))
+		for _, eventStream := range eventStreams.EventStreams {
+			objects = append(objects, inventoryObject{ID: eventStream.GetID(), Name: eventStream.GetName()})
+		}
+
+		return objects, eventStreams.List, nil
+	})
+}
+
+func listLogStreamObjects(ctx context.Context, inventory *inventory) ([]inventoryObject, error) {
+	var logStreams []*management.LogStream
+	if err := inventory.request(ctx, func() (err error) {
+		logStreams, err = inventory.api.LogStream.List(ctx)
+		return err
+	}); err != nil {
+		return nil, err
+	}
+
+	objects := make([]inventoryObject, 0, len(logStreams))
+	for _, logStream := range logStreams {
+		objects = append(objects, inventoryObject{ID: logStream.GetID(), Name: logStream.GetName()})
+	}
+
+	return objects, nil
+}
+
+func listCustomDomainObjects(ctx context.Context, inventory *inventory) ([]inventoryObject, error) {
+	var customDomains []*management.CustomDomain
+	if err := inventory.request(ctx, func() (err error) {
+		customDomains, err = inventory.api.CustomDomain.List(ctx)
+		return err
+	}); err != nil {
+		return nil, err
+	}
+
+	objects := make([]inventoryObject, 0, len(customDomains))
+	for _, customDomain := range customDomains {
+		objects = append(objects, inventoryObject{ID: customDomain.GetID(), Name: customDomain.GetDomain()})
+	}
+
+	return objects, nil
+}
+
+func listNetworkACLObjects(ctx context.Context, inventory *inventory) ([]inventoryObject, error) {
+	var networkACLs []*management.NetworkACL
+	if err := inventory.request(ctx, func() (err error) {
+		networkACLs, err = inventory.api.NetworkACL.List(ctx)
+		return err
+	}); err != nil {
+		return nil, err
+	}
+
+	objects := make([]inventoryObject, 0, len(networkACLs))
+	for _, networkACL := range networkACLs {
+		objects = append(objects, inventoryObject{ID: networkACL.GetID(), Name: networkACL.GetDescription()})
+	}
+
+	return objects, nil
+}
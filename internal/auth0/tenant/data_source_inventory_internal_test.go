@@ -0,0 +1,135 @@
+package tenant
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/auth0/go-auth0/management"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newInventoryTestAPI(t *testing.T, handler http.Handler) *management.Management {
+	t.Helper()
+
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	api, err := management.New(strings.TrimPrefix(server.URL, "http://"),
+		management.WithStaticToken("test-token"), management.WithInsecure(), management.WithNoRetries())
+	require.NoError(t, err)
+
+	return api
+}
+
+func writeJSON(t *testing.T, w http.ResponseWriter, body interface{}) {
+	t.Helper()
+
+	w.Header().Set("Content-Type", "application/json")
+	require.NoError(t, json.NewEncoder(w).Encode(body))
+}
+
+func TestInventory_List(t *testing.T) {
+	const clientCount = 250
+
+	var inFlight, maxInFlight atomic.Int32
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v2/clients", func(w http.ResponseWriter, r *http.Request) {
+		current := inFlight.Add(1)
+		defer inFlight.Add(-1)
+		for {
+			previous := maxInFlight.Load()
+			if current <= previous || maxInFlight.CompareAndSwap(previous, current) {
+				break
+			}
+		}
+
+		page, err := strconv.Atoi(r.URL.Query().Get("page"))
+		require.NoError(t, err)
+		perPage, err := strconv.Atoi(r.URL.Query().Get("per_page"))
+		require.NoError(t, err)
+
+		var clients []map[string]string
+		for index := page * perPage; index < (page+1)*perPage && index < clientCount; index++ {
+			clients = append(clients, map[string]string{
+				"client_id": fmt.Sprintf("client-%03d", index),
+				"name":      fmt.Sprintf("Client %03d", clientCount-index),
+			})
+		}
+
+		writeJSON(t, w, map[string]interface{}{
+			"start":   page * perPage,
+			"limit":   perPage,
+			"length":  len(clients),
+			"total":   clientCount,
+			"clients": clients,
+		})
+	})
+	mux.HandleFunc("/api/v2/connections", func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Query().Get("from") {
+		case "":
+			writeJSON(t, w, map[string]interface{}{
+				"next":        "checkpoint",
+				"connections": []map[string]string{{"id": "con_2", "name": "Username-Password"}},
+			})
+		case "checkpoint":
+			writeJSON(t, w, map[string]interface{}{
+				"connections": []map[string]string{{"id": "con_1", "name": "google-oauth2"}},
+			})
+		default:
+			t.Errorf("Unexpected checkpoint %q", r.URL.Query().Get("from"))
+		}
+	})
+	mux.HandleFunc("/api/v2/custom-domains", func(w http.ResponseWriter, _ *http.Request) {
+		writeJSON(t, w, []map[string]string{{"custom_domain_id": "cd_1", "domain": "login.example.com"}})
+	})
+
+	inventory := newInventory(newInventoryTestAPI(t, mux), 2)
+
+	objects, err := inventory.list(context.Background(), []string{"connection", "custom_domain", "client"})
+	require.NoError(t, err)
+	require.Len(t, objects, clientCount+3)
+
+	assert.Equal(t, inventoryObject{ID: "client-249", Name: "Client 001", Type: "client"}, objects[0])
+	assert.Equal(t, inventoryObject{ID: "client-000", Name: "Client 250", Type: "client"}, objects[clientCount-1])
+	assert.Equal(t, []inventoryObject{
+		{ID: "con_2", Name: "Username-Password", Type: "connection"},
+		{ID: "con_1", Name: "google-oauth2", Type: "connection"},
+		{ID: "cd_1", Name: "login.example.com", Type: "custom_domain"},
+	}, objects[clientCount:])
+
+	assert.LessOrEqual(t, maxInFlight.Load(), int32(2))
+}
+
+func TestInventory_ListError(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v2/roles", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusForbidden)
+		_, _ = w.Write([]byte(`{"statusCode":403,"error":"Forbidden","message":"Insufficient scope"}`))
+	})
+
+	inventory := newInventory(newInventoryTestAPI(t, mux), 4)
+
+	_, err := inventory.list(context.Background(), []string{"role"})
+	assert.ErrorContains(t, err, "failed to list the role objects: 403 Forbidden: Insufficient scope")
+}
+
+func TestFlattenInventoryObjects(t *testing.T) {
+	assert.Equal(t, []interface{}{
+		map[string]interface{}{
+			"id":            "rol_1",
+			"name":          "Admin",
+			"type":          "role",
+			"resource_type": "auth0_role",
+		},
+	}, flattenInventoryObjects([]inventoryObject{{ID: "rol_1", Name: "Admin", Type: "role"}}))
+}
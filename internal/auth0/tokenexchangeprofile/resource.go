@@ -8,11 +8,12 @@ import (
 
 	"github.com/auth0/terraform-provider-auth0/internal/config"
 	internalError "github.com/auth0/terraform-provider-auth0/internal/error"
+	internalSchema "github.com/auth0/terraform-provider-auth0/internal/schema"
 )
 
 // NewResource will return a new auth0_token_exchange_profile resource.
 func NewResource() *schema.Resource {
-	return &schema.Resource{
+	return internalSchema.WithIDIdentity(&schema.Resource{
 		CreateContext: createTokenExchangeProfile,
 		ReadContext:   readTokenExchangeProfile,
 		UpdateContext: updateTokenExchangeProfile,
@@ -54,7 +55,7 @@ func NewResource() *schema.Resource {
 				Description: "The ISO 8601 formatted date the credential was updated.",
 			},
 		},
-	}
+	})
 }
 
 func createTokenExchangeProfile(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -0,0 +1,108 @@
+package user
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/auth0/go-auth0/management"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/auth0/terraform-provider-auth0/internal/config"
+	internalSchema "github.com/auth0/terraform-provider-auth0/internal/schema"
+)
+
+const (
+	// usersPerPage is the number of users requested per page.
+	usersPerPage = 100
+
+	// maxUserSearchResults is the number of users the Management API returns at most per search.
+	maxUserSearchResults = 1000
+)
+
+// NewListResource will return a new auth0_user list resource.
+func NewListResource() list.ListResource {
+	return internalSchema.NewListResource(internalSchema.ListResourceConfig{
+		TypeName: "_user",
+		Resource: NewResource,
+		Schema: schema.Schema{
+			MarkdownDescription: "With this list resource, you can find the users of the tenant, " +
+				"e.g. to import them with `terraform query`. The Management API returns at most " +
+				"1000 users per search, so the listing stops there with a warning, use `query` to narrow " +
+				"down large tenants.",
+			Attributes: map[string]schema.Attribute{
+				"query": schema.StringAttribute{
+					Optional:            true,
+					MarkdownDescription: "Lucene Query for retrieving users, e.g. `email.domain:\"example.com\"`.",
+				},
+			},
+		},
+		List: listUsers,
+	})
+}
+
+type listUsersModel struct {
+	Query types.String `tfsdk:"query"`
+}
+
+func listUsers(
+	ctx context.Context,
+	filters tfsdk.Config,
+	meta interface{},
+	yield func(internalSchema.ListItem) bool,
+) diag.Diagnostics {
+	api := meta.(*config.Config).GetAPI()
+
+	var data listUsersModel
+	diagnostics := filters.Get(ctx, &data)
+	if diagnostics.HasError() {
+		return diagnostics
+	}
+
+	params := []management.RequestOption{
+		management.PerPage(usersPerPage),
+	}
+
+	if query := data.Query.ValueString(); query != "" {
+		params = append(params, management.Parameter("q", query))
+	}
+
+	var page int
+	for {
+		users, err := api.User.List(ctx, append(params, management.Page(page))...)
+		if err != nil {
+			diagnostics.AddError("Failed to List Users", err.Error())
+			return diagnostics
+		}
+
+		for _, user := range users.Users {
+			displayName := user.GetEmail()
+			if displayName == "" {
+				displayName = user.GetName()
+			}
+
+			if !yield(internalSchema.ListItem{ID: user.GetID(), DisplayName: displayName}) {
+				return diagnostics
+			}
+		}
+
+		if !users.HasNext() {
+			return diagnostics
+		}
+
+		// Requesting the users past the limit fails.
+		if (page+1)*usersPerPage >= maxUserSearchResults {
+			diagnostics.AddWarning(
+				"Incomplete List of Users",
+				fmt.Sprintf("The search matched %d users, but the Management API only returns the first %d. "+
+					"Use the query to narrow down the search.", users.Total, maxUserSearchResults),
+			)
+			return diagnostics
+		}
+
+		page++
+	}
+}
@@ -13,13 +13,14 @@ import (
 
 	"github.com/auth0/terraform-provider-auth0/internal/config"
 	internalError "github.com/auth0/terraform-provider-auth0/internal/error"
+	internalSchema "github.com/auth0/terraform-provider-auth0/internal/schema"
 )
 
 type validateUserFunc func(*management.User) error
 
 // NewResource will return a new auth0_user resource.
 func NewResource() *schema.Resource {
-	return &schema.Resource{
+	return internalSchema.WithIDIdentity(&schema.Resource{
 		CreateContext: createUser,
 		ReadContext:   readUser,
 		UpdateContext: updateUser,
@@ -153,7 +154,7 @@ func NewResource() *schema.Resource {
 					"Global setting of provider takes precedence over resource specific param, if both are set.",
 			},
 		},
-	}
+	})
 }
 
 func createUser(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
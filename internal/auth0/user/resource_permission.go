@@ -14,7 +14,7 @@ import (
 
 // NewPermissionResource will return a new auth0_connection_client resource.
 func NewPermissionResource() *schema.Resource {
-	return &schema.Resource{
+	return internalSchema.WithGroupIdentity(&schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"user_id": {
 				Type:        schema.TypeString,
@@ -52,7 +52,7 @@ func NewPermissionResource() *schema.Resource {
 			StateContext: internalSchema.ImportResourceGroupID("user_id", "resource_server_identifier", "permission"),
 		},
 		Description: "With this resource, you can manage user permissions.",
-	}
+	}, "user_id", "resource_server_identifier", "permission")
 }
 
 func createUserPermission(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
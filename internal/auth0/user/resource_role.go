@@ -7,6 +7,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
+	"github.com/auth0/terraform-provider-auth0/internal/auth0/role"
 	"github.com/auth0/terraform-provider-auth0/internal/config"
 	internalError "github.com/auth0/terraform-provider-auth0/internal/error"
 	internalSchema "github.com/auth0/terraform-provider-auth0/internal/schema"
@@ -14,7 +15,7 @@ import (
 
 // NewRoleResource will return a new auth0_user_role (1:1) resource.
 func NewRoleResource() *schema.Resource {
-	return &schema.Resource{
+	return internalSchema.WithGroupIdentity(&schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"user_id": {
 				Type:     schema.TypeString,
@@ -46,10 +47,16 @@ func NewRoleResource() *schema.Resource {
 		ReadContext:   readUserRole,
 		DeleteContext: deleteUserRole,
 		Importer: &schema.ResourceImporter{
-			StateContext: internalSchema.ImportResourceGroupID("user_id", "role_id"),
+			StateContext: internalSchema.ImportResourceGroupIDResolving(
+				map[string]internalSchema.ImportIDResolvers{
+					"role_id": role.ImportIDResolvers,
+				},
+				"user_id",
+				"role_id",
+			),
 		},
 		Description: "With this resource, you can manage assigned roles for a user.",
-	}
+	}, "user_id", "role_id")
 }
 
 func createUserRole(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -10,11 +10,12 @@ import (
 
 	"github.com/auth0/terraform-provider-auth0/internal/config"
 	internalError "github.com/auth0/terraform-provider-auth0/internal/error"
+	internalSchema "github.com/auth0/terraform-provider-auth0/internal/schema"
 )
 
 // NewResource will return a new auth0_user_attribute_profile resource.
 func NewResource() *schema.Resource {
-	return &schema.Resource{
+	return internalSchema.WithIDIdentity(&schema.Resource{
 		CreateContext: createUserAttributeProfile,
 		ReadContext:   readUserAttributeProfile,
 		UpdateContext: updateUserAttributeProfile,
@@ -260,7 +261,7 @@ func NewResource() *schema.Resource {
 				},
 			},
 		},
-	}
+	})
 }
 
 func createUserAttributeProfile(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
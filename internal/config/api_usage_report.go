@@ -0,0 +1,334 @@
+package config
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// apiUsageReportEnvVar names the environment variable holding the
+// path of the file the API usage report is written to at shutdown.
+const apiUsageReportEnvVar = "AUTH0_API_USAGE_REPORT"
+
+// endpointUsage accumulates the requests sent to a method and endpoint template.
+type endpointUsage struct {
+	count         int
+	rateLimitHits int
+	// waitDuration is the time actually spent waiting for the rate limit to reset before retrying.
+	waitDuration time.Duration
+	// pacingDuration is the time actually spent delaying requests to stay within the rate limit.
+	pacingDuration time.Duration
+	latencies      []time.Duration
+}
+
+// apiUsageReport is the JSON document written to the file named by AUTH0_API_USAGE_REPORT.
+type apiUsageReport struct {
+	TotalRequests int                   `json:"total_requests"`
+	RateLimitHits int                   `json:"rate_limit_hits"`
+	Endpoints     []endpointUsageReport `json:"endpoints"`
+}
+
+// endpointUsageReport is the usage of a method and endpoint template, with durations in milliseconds.
+type endpointUsageReport struct {
+	Method                 string               `json:"method"`
+	Endpoint               string               `json:"endpoint"`
+	Count                  int                  `json:"count"`
+	RateLimitHits          int                  `json:"rate_limit_hits"`
+	WaitMilliseconds       int64                `json:"wait_ms"`
+	PacingWaitMilliseconds int64                `json:"pacing_wait_ms"`
+	Latency                latencyPercentilesMS `json:"latency_ms"`
+}
+
+type latencyPercentilesMS struct {
+	P50 int64 `json:"p50"`
+	P90 int64 `json:"p90"`
+	P99 int64 `json:"p99"`
+	Max int64 `json:"max"`
+}
+
+// endpointUsage returns the usage of the method and endpoint template. Callers must hold the lock.
+func (m *rateLimitMetrics) endpointUsage(method, endpoint string) *endpointUsage {
+	if m.endpoints == nil {
+		m.endpoints = make(map[string]*endpointUsage)
+	}
+
+	key := method + " " + endpoint
+	usage, ok := m.endpoints[key]
+	if !ok {
+		usage = &endpointUsage{}
+		m.endpoints[key] = usage
+	}
+
+	return usage
+}
+
+// recordEndpointRequest records a request sent to the API, including every retry of it.
+func (m *rateLimitMetrics) recordEndpointRequest(method, endpoint string, wasRateLimited bool, latency time.Duration) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	usage := m.endpointUsage(method, endpoint)
+	usage.count++
+	usage.latencies = append(usage.latencies, latency)
+
+	if wasRateLimited {
+		usage.rateLimitHits++
+	}
+}
+
+// recordRateLimitWait records the time a request to the endpoint waited
+// for the rate limit to reset, before being retried after a 429.
+func (m *rateLimitMetrics) recordRateLimitWait(method, endpoint string, wait time.Duration) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.endpointUsage(method, endpoint).waitDuration += wait
+}
+
+// recordPacingWait records the time a request to the endpoint was
+// delayed by the pacer to stay within the rate limit.
+func (m *rateLimitMetrics) recordPacingWait(method, endpoint string, wait time.Duration) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.endpointUsage(method, endpoint).pacingDuration += wait
+	m.totalPacingDuration += wait
+}
+
+// endpointReports returns the usage of every endpoint, the
+// most requested ones first. Callers must hold the lock.
+func (m *rateLimitMetrics) endpointReports() []endpointUsageReport {
+	reports := make([]endpointUsageReport, 0, len(m.endpoints))
+	for key, usage := range m.endpoints {
+		method, endpoint, _ := strings.Cut(key, " ")
+
+		latencies := append([]time.Duration{}, usage.latencies...)
+		sort.Slice(latencies, func(i, j int) bool { return latencies[i] < latencies[j] })
+
+		reports = append(reports, endpointUsageReport{
+			Method:                 method,
+			Endpoint:               endpoint,
+			Count:                  usage.count,
+			RateLimitHits:          usage.rateLimitHits,
+			WaitMilliseconds:       usage.waitDuration.Milliseconds(),
+			PacingWaitMilliseconds: usage.pacingDuration.Milliseconds(),
+			Latency: latencyPercentilesMS{
+				P50: percentile(latencies, 50).Milliseconds(),
+				P90: percentile(latencies, 90).Milliseconds(),
+				P99: percentile(latencies, 99).Milliseconds(),
+				Max: percentile(latencies, 100).Milliseconds(),
+			},
+		})
+	}
+
+	sort.Slice(reports, func(i, j int) bool {
+		if reports[i].Count != reports[j].Count {
+			return reports[i].Count > reports[j].Count
+		}
+		if reports[i].Endpoint != reports[j].Endpoint {
+			return reports[i].Endpoint < reports[j].Endpoint
+		}
+		return reports[i].Method < reports[j].Method
+	})
+
+	return reports
+}
+
+// percentile returns the nearest-rank percentile of the sorted durations.
+func percentile(sorted []time.Duration, p float64) time.Duration {
+	if len(sorted) == 0 {
+		return 0
+	}
+
+	rank := int(math.Ceil(p / 100 * float64(len(sorted))))
+	if rank < 1 {
+		rank = 1
+	}
+
+	return sorted[rank-1]
+}
+
+// writeReport writes the usage report to the file at path, unless no request was sent.
+func (m *rateLimitMetrics) writeReport(path string) error {
+	m.mu.Lock()
+	if len(m.endpoints) == 0 {
+		// Terraform also starts the provider to validate the configuration or read the
+		// schema, those processes don't have anything to report.
+		m.mu.Unlock()
+		return nil
+	}
+
+	report := apiUsageReport{
+		TotalRequests: m.totalRequests,
+		RateLimitHits: m.rateLimitHits,
+		Endpoints:     m.endpointReports(),
+	}
+	m.mu.Unlock()
+
+	buffer, err := json.MarshalIndent(report, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return fmt.Errorf("failed to create the API usage report directory: %w", err)
+	}
+
+	if err := os.WriteFile(filepath.Clean(path), buffer, 0o600); err != nil {
+		return fmt.Errorf("failed to write the API usage report: %w", err)
+	}
+
+	return nil
+}
+
+// WriteAPIUsageReport writes the per-endpoint API usage of the provider process to a
+// file named after the AUTH0_API_USAGE_REPORT environment variable, if it is set, and the
+// process ID, as Terraform runs a provider process per command. For example, the report of
+// the process 1234 is written to `auth0-api-usage.1234.json` for `auth0-api-usage.json`.
+// It is meant to be called when the provider shuts down.
+func WriteAPIUsageReport() error {
+	path := os.Getenv(apiUsageReportEnvVar)
+	if path == "" {
+		return nil
+	}
+
+	return globalMetrics.writeReport(apiUsageReportPath(path, os.Getpid()))
+}
+
+// apiUsageReportPath returns the path of the report of the process.
+func apiUsageReportPath(path string, pid int) string {
+	extension := filepath.Ext(path)
+	return strings.TrimSuffix(path, extension) + "." + strconv.Itoa(pid) + extension
+}
+
+// apiUsageTransport wraps an http.RoundTripper to record the usage of every endpoint.
+// It sits below the retry logic so that every attempt is recorded on its own.
+type apiUsageTransport struct {
+	transport http.RoundTripper
+	metrics   *rateLimitMetrics
+}
+
+func (t *apiUsageTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	start := time.Now()
+	resp, err := t.transport.RoundTrip(req)
+	latency := time.Since(start)
+
+	wasRateLimited := err == nil && resp.StatusCode == http.StatusTooManyRequests
+
+	t.metrics.recordEndpointRequest(req.Method, endpointTemplate(req.URL.Path), wasRateLimited, latency)
+
+	return resp, err
+}
+
+func newAPIUsageTransport(tripper http.RoundTripper, metrics *rateLimitMetrics) http.RoundTripper {
+	return &apiUsageTransport{
+		transport: tripper,
+		metrics:   metrics,
+	}
+}
+
+// rateLimitAttemptsContextKey holds the rateLimitAttempts of a request in its context.
+type rateLimitAttemptsContextKey struct{}
+
+// rateLimitAttempts tracks the last attempt the rate limit retries made for a request.
+type rateLimitAttempts struct {
+	lastEndedAt     time.Time
+	lastRateLimited bool
+}
+
+// rateLimitAttemptsTransport wraps the rate limit retries to track their attempts in the request context.
+type rateLimitAttemptsTransport struct {
+	transport http.RoundTripper
+}
+
+func (t *rateLimitAttemptsTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := context.WithValue(req.Context(), rateLimitAttemptsContextKey{}, &rateLimitAttempts{})
+	return t.transport.RoundTrip(req.WithContext(ctx))
+}
+
+// rateLimitWaitTransport sits below the rate limit retries to record how long they actually
+// waited for the rate limit to reset, that is the time between a 429 and the next attempt.
+type rateLimitWaitTransport struct {
+	transport http.RoundTripper
+	metrics   *rateLimitMetrics
+}
+
+func (t *rateLimitWaitTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	attempts, ok := req.Context().Value(rateLimitAttemptsContextKey{}).(*rateLimitAttempts)
+	if !ok {
+		return t.transport.RoundTrip(req)
+	}
+
+	if attempts.lastRateLimited {
+		t.metrics.recordRateLimitWait(req.Method, endpointTemplate(req.URL.Path), time.Since(attempts.lastEndedAt))
+	}
+
+	resp, err := t.transport.RoundTrip(req)
+
+	attempts.lastEndedAt = time.Now()
+	attempts.lastRateLimited = err == nil && resp.StatusCode == http.StatusTooManyRequests
+
+	return resp, err
+}
+
+// measuredRateLimitTransport retries the requests hitting the rate limit like
+// rateLimitTransport, recording the time spent waiting in between in the metrics.
+func measuredRateLimitTransport(tripper http.RoundTripper, maxWait time.Duration, metrics *rateLimitMetrics) http.RoundTripper {
+	return &rateLimitAttemptsTransport{
+		transport: rateLimitTransport(
+			&rateLimitWaitTransport{
+				transport: tripper,
+				metrics:   metrics,
+			},
+			maxWait,
+		),
+	}
+}
+
+// endpointTemplate replaces the identifiers in the path with `{id}`, so that
+// requests to the same endpoint are grouped together regardless of the object.
+// For example, /api/v2/organizations/org_123/members becomes /api/v2/organizations/{id}/members.
+func endpointTemplate(path string) string {
+	segments := strings.Split(path, "/")
+	for i, segment := range segments {
+		if isIdentifierSegment(segment) {
+			segments[i] = "{id}"
+		}
+	}
+
+	return strings.Join(segments, "/")
+}
+
+// isIdentifierSegment reports whether the path segment is an object identifier rather than
+// a fixed part of the endpoint. Fixed parts are made of lowercase words and dashes, while
+// identifiers hold digits, connection prefixes (e.g. `auth0|`) or are long random strings.
+func isIdentifierSegment(segment string) bool {
+	if segment == "" || segment == "v2" {
+		return false
+	}
+
+	if strings.ContainsAny(segment, "0123456789|@%:") {
+		return true
+	}
+
+	if len(segment) < 20 {
+		return false
+	}
+
+	for _, r := range segment {
+		if !unicode.IsLetter(r) {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,129 @@
+package config
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEndpointTemplate(t *testing.T) {
+	var testCases = map[string]string{
+		"/api/v2/clients":                                              "/api/v2/clients",
+		"/api/v2/organizations/org_1A2b3C/members":                     "/api/v2/organizations/{id}/members",
+		"/api/v2/users/auth0|64f1a2b3c4d5e6f7a8b9c0d1/roles":           "/api/v2/users/{id}/roles",
+		"/api/v2/clients/pNsCPvaAcWwHYjIBvPyxeCjbQgzgTdPz":             "/api/v2/clients/{id}",
+		"/api/v2/attack-protection/breached-password-detection":        "/api/v2/attack-protection/breached-password-detection",
+		"/api/v2/prompts/login/custom-text/en":                         "/api/v2/prompts/login/custom-text/en",
+		"/api/v2/guardian/factors/sms/providers/twilio":                "/api/v2/guardian/factors/sms/providers/twilio",
+		"/api/v2/actions/actions/4c2b2d5e-6a4b-4b8e-9b0e-1a2b3c4d5e6f": "/api/v2/actions/actions/{id}",
+		"/oauth/token": "/oauth/token",
+	}
+
+	for path, expected := range testCases {
+		t.Run(path, func(t *testing.T) {
+			assert.Equal(t, expected, endpointTemplate(path))
+		})
+	}
+}
+
+func TestPercentile(t *testing.T) {
+	var durations []time.Duration
+	for i := 1; i <= 100; i++ {
+		durations = append(durations, time.Duration(i)*time.Millisecond)
+	}
+
+	assert.Equal(t, time.Duration(0), percentile(nil, 50))
+	assert.Equal(t, 50*time.Millisecond, percentile(durations, 50))
+	assert.Equal(t, 90*time.Millisecond, percentile(durations, 90))
+	assert.Equal(t, 99*time.Millisecond, percentile(durations, 99))
+	assert.Equal(t, 100*time.Millisecond, percentile(durations, 100))
+	assert.Equal(t, 7*time.Millisecond, percentile([]time.Duration{7 * time.Millisecond}, 99))
+}
+
+func TestAPIUsageReport(t *testing.T) {
+	rateLimited := true
+	testServer := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		if request.URL.Path == "/api/v2/organizations/org_2/members" && rateLimited {
+			rateLimited = false
+			writer.Header().Set("X-RateLimit-Reset", strconv.FormatInt(time.Now().Add(2*time.Second).Unix(), 10))
+			writer.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		writer.WriteHeader(http.StatusOK)
+	}))
+	t.Cleanup(testServer.Close)
+
+	metrics := &rateLimitMetrics{minRemaining: -1}
+	client := &http.Client{
+		Transport: measuredRateLimitTransport(newAPIUsageTransport(http.DefaultTransport, metrics), 0, metrics),
+	}
+
+	for _, path := range []string{
+		"/api/v2/organizations/org_1/members",
+		"/api/v2/organizations/org_2/members",
+		"/api/v2/organizations/org_3/members",
+		"/api/v2/clients",
+	} {
+		doRequest(t, client, http.MethodGet, testServer.URL+path)
+	}
+	doRequest(t, client, http.MethodDelete, testServer.URL+"/api/v2/clients/abc123")
+
+	directory := t.TempDir()
+	t.Setenv(apiUsageReportEnvVar, filepath.Join(directory, "reports", "usage.json"))
+	path := filepath.Join(directory, "reports", "usage."+strconv.Itoa(os.Getpid())+".json")
+
+	originalMetrics := globalMetrics
+	globalMetrics = metrics
+	t.Cleanup(func() { globalMetrics = originalMetrics })
+
+	require.NoError(t, WriteAPIUsageReport())
+
+	buffer, err := os.ReadFile(path)
+	require.NoError(t, err)
+
+	var report apiUsageReport
+	require.NoError(t, json.Unmarshal(buffer, &report))
+	require.Len(t, report.Endpoints, 3)
+
+	members := report.Endpoints[0]
+	assert.Equal(t, "GET", members.Method)
+	assert.Equal(t, "/api/v2/organizations/{id}/members", members.Endpoint)
+	assert.Equal(t, 4, members.Count)
+	assert.Equal(t, 1, members.RateLimitHits)
+	// The wait is the time actually slept until the retry, not the time until the reset.
+	assert.Greater(t, members.WaitMilliseconds, int64(500))
+	assert.Less(t, members.WaitMilliseconds, int64(2500))
+	assert.LessOrEqual(t, members.Latency.P50, members.Latency.Max)
+
+	assert.Equal(t, "/api/v2/clients", report.Endpoints[1].Endpoint)
+	assert.Equal(t, "DELETE", report.Endpoints[2].Method)
+	assert.Equal(t, "/api/v2/clients/{id}", report.Endpoints[2].Endpoint)
+	assert.Equal(t, 0, report.Endpoints[2].RateLimitHits)
+}
+
+func TestWriteAPIUsageReport_Unset(t *testing.T) {
+	t.Setenv(apiUsageReportEnvVar, "")
+	assert.NoError(t, WriteAPIUsageReport())
+}
+
+func TestWriteAPIUsageReport_SkipsProcessesWithoutRequests(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "usage.json")
+
+	require.NoError(t, (&rateLimitMetrics{minRemaining: -1}).writeReport(path))
+
+	_, err := os.Stat(path)
+	assert.ErrorIs(t, err, os.ErrNotExist)
+}
+
+func TestAPIUsageReportPath(t *testing.T) {
+	assert.Equal(t, "reports/usage.1234.json", apiUsageReportPath("reports/usage.json", 1234))
+	assert.Equal(t, "usage.1234", apiUsageReportPath("usage", 1234))
+}
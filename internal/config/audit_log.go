@@ -0,0 +1,174 @@
+package config
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// auditRequestIDHeader is the response header holding the ID the Auth0 Management API gave to the request.
+const auditRequestIDHeader = "X-Auth0-RequestId"
+
+// auditLogMu serializes the writes to the audit log of the v1 and v3 clients.
+var auditLogMu sync.Mutex
+
+// auditLogEntry is a line of the audit log, recording a request that changed the tenant.
+type auditLogEntry struct {
+	Timestamp    time.Time       `json:"timestamp"`
+	Method       string          `json:"method"`
+	Path         string          `json:"path"`
+	Status       int             `json:"status,omitempty"`
+	RequestID    string          `json:"request_id,omitempty"`
+	ResourceType string          `json:"resource_type,omitempty"`
+	Body         json.RawMessage `json:"body,omitempty"`
+	Error        string          `json:"error,omitempty"`
+}
+
+type resourceTypeContextKey struct{}
+
+// ContextWithResourceType returns a copy of the context carrying the
+// Terraform resource type recorded in the audit log for its requests.
+func ContextWithResourceType(ctx context.Context, resourceType string) context.Context {
+	return context.WithValue(ctx, resourceTypeContextKey{}, resourceType)
+}
+
+func resourceTypeFromContext(ctx context.Context) string {
+	resourceType, _ := ctx.Value(resourceTypeContextKey{}).(string)
+	return resourceType
+}
+
+// WithResourceTypeContext wraps the CRUD functions of the resources so that the
+// context they receive carries their resource type, as set by ContextWithResourceType.
+func WithResourceTypeContext(resources map[string]*schema.Resource) map[string]*schema.Resource {
+	for resourceType, resource := range resources {
+		resource.CreateContext = withResourceType(resourceType, resource.CreateContext)
+		resource.ReadContext = withResourceType(resourceType, resource.ReadContext)
+		resource.UpdateContext = withResourceType(resourceType, resource.UpdateContext)
+		resource.DeleteContext = withResourceType(resourceType, resource.DeleteContext)
+	}
+
+	return resources
+}
+
+func withResourceType[F ~func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics](
+	resourceType string,
+	function F,
+) F {
+	if function == nil {
+		return nil
+	}
+
+	return func(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		return function(ContextWithResourceType(ctx, resourceType), data, meta)
+	}
+}
+
+// auditLogTransport wraps an http.RoundTripper to append a line
+// to the audit log for every request that changes the tenant.
+type auditLogTransport struct {
+	transport http.RoundTripper
+	path      string
+}
+
+func (t *auditLogTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Method == http.MethodGet || !strings.HasPrefix(req.URL.Path, managementAPIBasePath) {
+		return t.transport.RoundTrip(req)
+	}
+
+	entry := auditLogEntry{
+		Timestamp:    time.Now().UTC(),
+		Method:       req.Method,
+		Path:         req.URL.Path,
+		ResourceType: resourceTypeFromContext(req.Context()),
+	}
+
+	req, body, err := bufferRequestBody(req)
+	if err != nil {
+		return nil, err
+	}
+	if body != nil {
+		entry.Body = redactJSON(body)
+	}
+
+	resp, err := t.transport.RoundTrip(req)
+	if err != nil {
+		entry.Error = err.Error()
+	} else {
+		entry.Status = resp.StatusCode
+		entry.RequestID = resp.Header.Get(auditRequestIDHeader)
+	}
+
+	if writeErr := appendAuditLogEntry(t.path, entry); writeErr != nil {
+		tflog.Error(req.Context(), "Failed to write to the audit log", map[string]interface{}{
+			"path":  t.path,
+			"error": writeErr.Error(),
+		})
+	}
+
+	return resp, err
+}
+
+func newAuditLogTransport(tripper http.RoundTripper, path string) http.RoundTripper {
+	return &auditLogTransport{
+		transport: tripper,
+		path:      path,
+	}
+}
+
+// redactJSON returns the JSON body with the value of the sensitive fields redacted.
+// Bodies that aren't JSON are redacted as a whole, as there is no telling what they hold.
+func redactJSON(body []byte) json.RawMessage {
+	redacted, err := defaultRedactionRules.redactJSON(body)
+	if err != nil {
+		redacted, _ = json.Marshal(redactedValue)
+	}
+
+	return redacted
+}
+
+// openAuditLog opens the audit log for appending, creating it
+// only readable by its owner if it doesn't exist yet.
+func openAuditLog(path string) (*os.File, error) {
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return nil, fmt.Errorf("failed to create the audit log directory: %w", err)
+	}
+
+	file, err := os.OpenFile(filepath.Clean(path), os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open the audit log: %w", err)
+	}
+
+	return file, nil
+}
+
+func appendAuditLogEntry(path string, entry auditLogEntry) error {
+	line, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+
+	auditLogMu.Lock()
+	defer auditLogMu.Unlock()
+
+	file, err := openAuditLog(path)
+	if err != nil {
+		return err
+	}
+
+	if _, err := file.Write(append(line, '\n')); err != nil {
+		_ = file.Close()
+		return fmt.Errorf("failed to write to the audit log: %w", err)
+	}
+
+	return file.Close()
+}
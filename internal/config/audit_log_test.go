@@ -0,0 +1,163 @@
+package config
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func readAuditLog(t *testing.T, path string) []map[string]interface{} {
+	t.Helper()
+
+	file, err := os.Open(path)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = file.Close() })
+
+	var entries []map[string]interface{}
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		var entry map[string]interface{}
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &entry))
+		entries = append(entries, entry)
+	}
+	require.NoError(t, scanner.Err())
+
+	return entries
+}
+
+func TestCustomClientWithRetries_AuditLog(t *testing.T) {
+	var receivedBodies []string
+	testServer := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		body, _ := io.ReadAll(request.Body)
+		receivedBodies = append(receivedBodies, string(body))
+
+		writer.Header().Set("X-Auth0-RequestId", "request-id")
+		writer.WriteHeader(http.StatusCreated)
+	}))
+	t.Cleanup(testServer.Close)
+
+	path := filepath.Join(t.TempDir(), "audit", "audit.log")
+	client := customClientWithRetries(ProviderConfig{AuditLogPath: path})
+
+	doRequest(t, client, http.MethodGet, testServer.URL+"/api/v2/clients")
+
+	body := `{"name":"app","client_secret":"secret","signing_keys":[{"cert":"cert"}],` +
+		`"options":{"password":"p4ss","enabled":true},"jwt_configuration":{"lifetime_in_seconds":36000}}`
+	ctx := ContextWithResourceType(context.Background(), "auth0_client")
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, testServer.URL+"/api/v2/clients?fields=name", strings.NewReader(body))
+	require.NoError(t, err)
+	response, err := client.Do(request)
+	require.NoError(t, err)
+	require.NoError(t, response.Body.Close())
+
+	doRequest(t, client, http.MethodDelete, testServer.URL+"/api/v2/clients/abc")
+
+	assert.Equal(t, []string{"", body, ""}, receivedBodies)
+
+	entries := readAuditLog(t, path)
+	require.Len(t, entries, 2)
+
+	assert.NotEmpty(t, entries[0]["timestamp"])
+	delete(entries[0], "timestamp")
+	assert.Equal(t, map[string]interface{}{
+		"method":        "POST",
+		"path":          "/api/v2/clients",
+		"status":        float64(http.StatusCreated),
+		"request_id":    "request-id",
+		"resource_type": "auth0_client",
+		"body": map[string]interface{}{
+			"name":              "app",
+			"client_secret":     redactedValue,
+			"signing_keys":      redactedValue,
+			"options":           map[string]interface{}{"password": redactedValue, "enabled": true},
+			"jwt_configuration": map[string]interface{}{"lifetime_in_seconds": float64(36000)},
+		},
+	}, entries[0])
+
+	assert.Equal(t, "DELETE", entries[1]["method"])
+	assert.Equal(t, "/api/v2/clients/abc", entries[1]["path"])
+	assert.NotContains(t, entries[1], "body")
+	assert.NotContains(t, entries[1], "resource_type")
+
+	info, err := os.Stat(path)
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0o600), info.Mode().Perm())
+}
+
+func TestRedactJSON(t *testing.T) {
+	assert.JSONEq(t, `[{"secret":"[REDACTED]","id":1}]`, string(redactJSON([]byte(`[{"secret":"s","id":1}]`))))
+	assert.JSONEq(t, `"[REDACTED]"`, string(redactJSON([]byte(`client_secret=secret`))))
+
+	var testCases = []struct {
+		name     string
+		body     string
+		expected string
+	}{
+		{
+			name:     "action secrets",
+			body:     `{"name":"action","secrets":[{"name":"API_KEY","value":"s3cr3t"}]}`,
+			expected: `{"name":"action","secrets":[{"name":"API_KEY","value":"[REDACTED]"}]}`,
+		},
+		{
+			name:     "log stream sinks",
+			body:     `{"type":"datadog","sink":{"datadogRegion":"us","datadogApiKey":"key"}}`,
+			expected: `{"type":"datadog","sink":{"datadogRegion":"us","datadogApiKey":"[REDACTED]"}}`,
+		},
+		{
+			name:     "connection configuration",
+			body:     `{"options":{"configuration":{"API_KEY":"key"},"enabled_database_customization":true}}`,
+			expected: `{"options":{"configuration":"[REDACTED]","enabled_database_customization":true}}`,
+		},
+		{
+			name:     "email provider credentials",
+			body:     `{"name":"sendgrid","credentials":{"api_key":"key"}}`,
+			expected: `{"name":"sendgrid","credentials":"[REDACTED]"}`,
+		},
+		{
+			name:     "guardian provider credentials",
+			body:     `{"sid":"sid","auth_token":"token","server_credentials":"{}"}`,
+			expected: `{"sid":"sid","auth_token":"[REDACTED]","server_credentials":"[REDACTED]"}`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			assert.JSONEq(t, testCase.expected, string(redactJSON([]byte(testCase.body))))
+		})
+	}
+}
+
+func TestWithResourceTypeContext(t *testing.T) {
+	var resourceTypes []string
+	recordResourceType := func(ctx context.Context, _ *schema.ResourceData, _ interface{}) diag.Diagnostics {
+		resourceTypes = append(resourceTypes, resourceTypeFromContext(ctx))
+		return nil
+	}
+
+	resources := WithResourceTypeContext(map[string]*schema.Resource{
+		"auth0_client": {
+			CreateContext: recordResourceType,
+			ReadContext:   recordResourceType,
+		},
+	})
+
+	resource := resources["auth0_client"]
+	assert.Nil(t, resource.UpdateContext)
+	assert.Nil(t, resource.DeleteContext)
+
+	resource.CreateContext(context.Background(), nil, nil)
+	resource.ReadContext(context.Background(), nil, nil)
+	assert.Equal(t, []string{"auth0_client", "auth0_client"}, resourceTypes)
+}
@@ -0,0 +1,98 @@
+package config
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+)
+
+// TransportConfig holds the network settings of the
+// connections made to the Auth0 Management API and token endpoint.
+type TransportConfig struct {
+	// ProxyURL is the URL of the HTTP proxy to send the requests through. Credentials
+	// in its user info are used to authenticate with the proxy. When it is empty,
+	// the proxy is picked from the HTTPS_PROXY and NO_PROXY environment variables.
+	ProxyURL string
+	// CABundlePEM and CABundleFile hold PEM encoded certificates
+	// to trust on top of the system certificate pool.
+	CABundlePEM  string
+	CABundleFile string
+	// ClientCertificate and ClientKey hold the PEM encoded
+	// certificate and private key used for mutual TLS.
+	ClientCertificate string
+	ClientKey         string
+}
+
+// isZero reports whether none of the settings are set.
+func (c TransportConfig) isZero() bool {
+	return c == TransportConfig{}
+}
+
+// newBaseTransport returns the transport sending the requests to Auth0, which is
+// http.DefaultTransport unless the proxy, trusted certificates or client certificate are configured.
+func newBaseTransport(cfg TransportConfig) (http.RoundTripper, error) {
+	if cfg.isZero() {
+		return http.DefaultTransport, nil
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+
+	if cfg.ProxyURL != "" {
+		proxyURL, err := url.Parse(cfg.ProxyURL)
+		if err != nil || proxyURL.Scheme == "" || proxyURL.Host == "" {
+			// The URL may embed credentials, so it isn't included in the error.
+			return nil, fmt.Errorf("the proxy_url must be an absolute URL such as http://proxy.example.com:3128")
+		}
+
+		transport.Proxy = http.ProxyURL(proxyURL)
+	}
+
+	tlsConfig := &tls.Config{
+		MinVersion: tls.VersionTLS12,
+	}
+
+	if cfg.CABundlePEM != "" || cfg.CABundleFile != "" {
+		rootCAs, err := x509.SystemCertPool()
+		if err != nil {
+			rootCAs = x509.NewCertPool()
+		}
+
+		if cfg.CABundlePEM != "" && !rootCAs.AppendCertsFromPEM([]byte(cfg.CABundlePEM)) {
+			return nil, fmt.Errorf("the ca_bundle_pem doesn't contain any PEM encoded certificate")
+		}
+
+		if cfg.CABundleFile != "" {
+			bundle, err := os.ReadFile(filepath.Clean(cfg.CABundleFile))
+			if err != nil {
+				return nil, fmt.Errorf("failed to read the ca_bundle_file: %w", err)
+			}
+
+			if !rootCAs.AppendCertsFromPEM(bundle) {
+				return nil, fmt.Errorf("the ca_bundle_file %q doesn't contain any PEM encoded certificate", cfg.CABundleFile)
+			}
+		}
+
+		tlsConfig.RootCAs = rootCAs
+	}
+
+	if cfg.ClientCertificate != "" || cfg.ClientKey != "" {
+		if cfg.ClientCertificate == "" || cfg.ClientKey == "" {
+			return nil, fmt.Errorf("the client_certificate and client_key must be configured together")
+		}
+
+		certificate, err := tls.X509KeyPair([]byte(cfg.ClientCertificate), []byte(cfg.ClientKey))
+		if err != nil {
+			return nil, fmt.Errorf("invalid client_certificate or client_key: %w", err)
+		}
+
+		tlsConfig.Certificates = []tls.Certificate{certificate}
+	}
+
+	transport.TLSClientConfig = tlsConfig
+
+	return transport, nil
+}
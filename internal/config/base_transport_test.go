@@ -0,0 +1,160 @@
+package config
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"errors"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func certificatePEM(certificate *x509.Certificate) string {
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: certificate.Raw}))
+}
+
+func newTestClientCertificate(t *testing.T) (string, string) {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "terraform-provider-auth0"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+	certificate, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+
+	privateKey, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: certificate})),
+		string(pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: privateKey}))
+}
+
+func TestNewBaseTransport(t *testing.T) {
+	t.Run("it uses the default transport when nothing is configured", func(t *testing.T) {
+		transport, err := newBaseTransport(TransportConfig{})
+		require.NoError(t, err)
+		assert.Equal(t, http.DefaultTransport, transport)
+	})
+
+	t.Run("it trusts the certificates of the CA bundle", func(t *testing.T) {
+		testServer := httptest.NewTLSServer(http.HandlerFunc(func(writer http.ResponseWriter, _ *http.Request) {
+			writer.WriteHeader(http.StatusOK)
+		}))
+		t.Cleanup(testServer.Close)
+
+		caBundleFile := filepath.Join(t.TempDir(), "ca.pem")
+		require.NoError(t, os.WriteFile(caBundleFile, []byte(certificatePEM(testServer.Certificate())), 0o600))
+
+		for _, cfg := range []TransportConfig{
+			{CABundlePEM: certificatePEM(testServer.Certificate())},
+			{CABundleFile: caBundleFile},
+		} {
+			transport, err := newBaseTransport(cfg)
+			require.NoError(t, err)
+
+			status, _ := doRequest(t, customClientWithRetries(ProviderConfig{baseTransport: transport}), http.MethodGet, testServer.URL)
+			assert.Equal(t, http.StatusOK, status)
+		}
+	})
+
+	t.Run("it doesn't retry requests to servers with an unknown certificate authority", func(t *testing.T) {
+		attempts := 0
+		testServer := httptest.NewTLSServer(http.HandlerFunc(func(writer http.ResponseWriter, _ *http.Request) {
+			attempts++
+			writer.WriteHeader(http.StatusOK)
+		}))
+		t.Cleanup(testServer.Close)
+
+		otherCertificate, _ := newTestClientCertificate(t)
+		transport, err := newBaseTransport(TransportConfig{CABundlePEM: otherCertificate})
+		require.NoError(t, err)
+
+		_, err = customClientWithRetries(ProviderConfig{baseTransport: transport}).Get(testServer.URL)
+		require.Error(t, err)
+
+		var unknownAuthorityErr x509.UnknownAuthorityError
+		assert.True(t, errors.As(err, &unknownAuthorityErr))
+		assert.Equal(t, 0, attempts)
+	})
+
+	t.Run("it presents the client certificate", func(t *testing.T) {
+		var presented []*x509.Certificate
+		testServer := httptest.NewUnstartedServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+			presented = request.TLS.PeerCertificates
+			writer.WriteHeader(http.StatusOK)
+		}))
+		testServer.TLS = &tls.Config{ClientAuth: tls.RequireAnyClientCert, MinVersion: tls.VersionTLS12}
+		testServer.StartTLS()
+		t.Cleanup(testServer.Close)
+
+		clientCertificate, clientKey := newTestClientCertificate(t)
+		transport, err := newBaseTransport(TransportConfig{
+			CABundlePEM:       certificatePEM(testServer.Certificate()),
+			ClientCertificate: clientCertificate,
+			ClientKey:         clientKey,
+		})
+		require.NoError(t, err)
+
+		status, _ := doRequest(t, &http.Client{Transport: transport}, http.MethodGet, testServer.URL)
+		assert.Equal(t, http.StatusOK, status)
+		require.Len(t, presented, 1)
+		assert.Equal(t, "terraform-provider-auth0", presented[0].Subject.CommonName)
+	})
+
+	t.Run("it sends the requests through the proxy", func(t *testing.T) {
+		var proxied []string
+		proxyServer := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+			proxied = append(proxied, request.URL.String()+" "+request.Header.Get("Proxy-Authorization"))
+			writer.WriteHeader(http.StatusOK)
+		}))
+		t.Cleanup(proxyServer.Close)
+
+		transport, err := newBaseTransport(TransportConfig{
+			ProxyURL: "http://user:password@" + proxyServer.Listener.Addr().String(),
+		})
+		require.NoError(t, err)
+
+		status, _ := doRequest(t, &http.Client{Transport: transport}, http.MethodGet, "http://example.auth0.com/api/v2/clients")
+		assert.Equal(t, http.StatusOK, status)
+		assert.Equal(t, []string{"http://example.auth0.com/api/v2/clients Basic dXNlcjpwYXNzd29yZA=="}, proxied)
+	})
+
+	t.Run("it returns an error on invalid settings", func(t *testing.T) {
+		clientCertificate, _ := newTestClientCertificate(t)
+
+		var testCases = []struct {
+			cfg           TransportConfig
+			expectedError string
+		}{
+			{cfg: TransportConfig{ProxyURL: "proxy.example.com"}, expectedError: "must be an absolute URL"},
+			{cfg: TransportConfig{CABundlePEM: "not a certificate"}, expectedError: "doesn't contain any PEM encoded certificate"},
+			{cfg: TransportConfig{CABundleFile: filepath.Join(t.TempDir(), "missing.pem")}, expectedError: "failed to read the ca_bundle_file"},
+			{cfg: TransportConfig{ClientCertificate: clientCertificate}, expectedError: "must be configured together"},
+			{cfg: TransportConfig{ClientCertificate: clientCertificate, ClientKey: "not a key"}, expectedError: "invalid client_certificate or client_key"},
+		}
+
+		for _, testCase := range testCases {
+			_, err := newBaseTransport(testCase.cfg)
+			assert.ErrorContains(t, err, testCase.expectedError)
+		}
+	})
+}
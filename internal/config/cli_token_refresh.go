@@ -0,0 +1,260 @@
+package config
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/zalando/go-keyring"
+	"golang.org/x/oauth2"
+)
+
+const (
+	secretRefreshToken = "Auth0 CLI Refresh Token" // #nosec G101
+	secretClientSecret = "Auth0 CLI Client Secret" // #nosec G101
+
+	// The auth0-cli stores the access token in chunks of this size in the keyring.
+	secretAccessTokenChunkSize = 2048
+
+	// cliClientID is the ID of the public client the auth0-cli logs users in with.
+	cliClientID = "2iZo3Uczt5LFHacKdM0zzgUO2eG2uDjT"
+
+	// cliTokenRefreshMargin is how long before its expiry the auth0-cli token gets
+	// refreshed, so that it doesn't expire while a request is in flight.
+	cliTokenRefreshMargin = 5 * time.Minute
+)
+
+// cliTokenEndpoint returns the token endpoint used to refresh the auth0-cli token of a tenant.
+// Tenants logged in as a user refresh through the auth0-cli login tenant, while tenants
+// logged in with client credentials request a new token from the tenant itself.
+// It is a variable so that tests can point it to a fake token endpoint.
+var cliTokenEndpoint = func(domain string, usesClientCredentials bool) string {
+	if usesClientCredentials {
+		return "https://" + domain + "/oauth/token"
+	}
+
+	return "https://auth0.auth0.com/oauth/token"
+}
+
+// setKeyringSecret writes a secret to the keyring.
+// It is a variable so that tests can make writing a chunk of the token fail.
+var setKeyringSecret = keyring.Set
+
+// refreshCLIToken obtains a new access token for the tenant using the refresh token, or the
+// client credentials, stored by the auth0-cli, and writes it back where the auth0-cli expects it.
+func refreshCLIToken(domain string, httpClient *http.Client) (*oauth2.Token, error) {
+	clientID, err := getClientIDFromCliConfigFile(domain)
+	if err != nil {
+		return nil, err
+	}
+
+	form := url.Values{}
+	if clientID != "" {
+		clientSecret, err := keyring.Get(secretClientSecret, domain)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get the client secret stored by auth0-cli: %w", err)
+		}
+
+		form.Set("grant_type", "client_credentials")
+		form.Set("client_id", clientID)
+		form.Set("client_secret", clientSecret)
+		form.Set("audience", "https://"+domain+"/api/v2/")
+	} else {
+		refreshToken, err := keyring.Get(secretRefreshToken, domain)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get the refresh token stored by auth0-cli: %w", err)
+		}
+
+		form.Set("grant_type", "refresh_token")
+		form.Set("client_id", cliClientID)
+		form.Set("refresh_token", refreshToken)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	request, err := http.NewRequestWithContext(
+		ctx,
+		http.MethodPost,
+		cliTokenEndpoint(domain, clientID != ""),
+		strings.NewReader(form.Encode()),
+	)
+	if err != nil {
+		return nil, err
+	}
+	request.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	response, err := httpClient.Do(request)
+	if err != nil {
+		return nil, fmt.Errorf("failed to refresh the auth0-cli token: %w", err)
+	}
+	defer func() { _ = response.Body.Close() }()
+
+	if response.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to refresh the auth0-cli token: the token endpoint returned %s", response.Status)
+	}
+
+	var tokenResponse struct {
+		AccessToken string `json:"access_token"`
+		ExpiresIn   int64  `json:"expires_in"`
+	}
+	if err := json.NewDecoder(response.Body).Decode(&tokenResponse); err != nil {
+		return nil, fmt.Errorf("failed to parse the token endpoint response: %w", err)
+	}
+
+	if tokenResponse.AccessToken == "" {
+		return nil, fmt.Errorf("failed to refresh the auth0-cli token: the token endpoint returned no access token")
+	}
+
+	expiresAt := time.Now().Add(time.Duration(tokenResponse.ExpiresIn) * time.Second)
+	if err := storeCLIToken(domain, tokenResponse.AccessToken, expiresAt); err != nil {
+		return nil, err
+	}
+
+	return &oauth2.Token{AccessToken: tokenResponse.AccessToken, TokenType: "Bearer", Expiry: expiresAt}, nil
+}
+
+// cliTokenSource is an oauth2.TokenSource refreshing the auth0-cli token of a tenant.
+type cliTokenSource struct {
+	domain     string
+	httpClient *http.Client
+}
+
+func (s *cliTokenSource) Token() (*oauth2.Token, error) {
+	return refreshCLIToken(s.domain, s.httpClient)
+}
+
+// newCLITokenSource returns a token source starting with the given auth0-cli token and
+// refreshing it once it nears its expiry, so that long applies outlive the stored token.
+func newCLITokenSource(domain, accessToken string, httpClient *http.Client) oauth2.TokenSource {
+	token := &oauth2.Token{AccessToken: accessToken, TokenType: "Bearer"}
+	if expiresAt, err := tokenExpiresAt(accessToken); err == nil {
+		token.Expiry = expiresAt
+	}
+
+	return oauth2.ReuseTokenSourceWithExpiry(
+		token,
+		&cliTokenSource{domain: domain, httpClient: httpClient},
+		cliTokenRefreshMargin,
+	)
+}
+
+// storeCLIToken writes the access token to the keyring in the chunked format of the auth0-cli,
+// falling back to the config file like the auth0-cli does when the keyring is unavailable.
+func storeCLIToken(domain, accessToken string, expiresAt time.Time) error {
+	storedInKeyring := storeCLITokenInKeyring(domain, accessToken) == nil
+
+	return updateCliConfigFileTenant(domain, func(tenant map[string]interface{}) {
+		tenant["expires_at"] = expiresAt.Format(time.RFC3339Nano)
+
+		if storedInKeyring {
+			delete(tenant, "access_token")
+		} else {
+			tenant["access_token"] = accessToken
+		}
+	})
+}
+
+func storeCLITokenInKeyring(domain, accessToken string) error {
+	chunks := 0
+	for ; chunks*secretAccessTokenChunkSize < len(accessToken); chunks++ {
+		end := (chunks + 1) * secretAccessTokenChunkSize
+		if end > len(accessToken) {
+			end = len(accessToken)
+		}
+
+		chunk := accessToken[chunks*secretAccessTokenChunkSize : end]
+		if err := setKeyringSecret(fmt.Sprintf("%s %d", secretAccessToken, chunks), domain, chunk); err != nil {
+			// Don't leave a truncated token behind, as the keyring is read before the config file.
+			deleteCLITokenChunksFromKeyring(domain, 0)
+			return err
+		}
+	}
+
+	// The trailing chunks of a longer previous token are only deleted once the new one is
+	// fully written, so that a failure halfway never leaves a mix of both tokens behind.
+	deleteCLITokenChunksFromKeyring(domain, chunks)
+
+	return nil
+}
+
+// deleteCLITokenChunksFromKeyring deletes the chunks of the auth0-cli access token
+// from the keyring, starting with the given chunk and up to the first missing one.
+func deleteCLITokenChunksFromKeyring(domain string, from int) {
+	for i := from; i < secretAccessTokenMaxChunks; i++ {
+		if err := keyring.Delete(fmt.Sprintf("%s %d", secretAccessToken, i), domain); err != nil {
+			break
+		}
+	}
+}
+
+// getClientIDFromCliConfigFile returns the client ID the auth0-cli logged in to the tenant with,
+// which is empty when the auth0-cli was logged in as a user.
+func getClientIDFromCliConfigFile(domain string) (string, error) {
+	if _, err := os.Stat(cliConfigPath); os.IsNotExist(err) {
+		return "", nil
+	}
+
+	buffer, err := os.ReadFile(path.Clean(cliConfigPath))
+	if err != nil {
+		return "", fmt.Errorf("failed to read config.json: %w", err)
+	}
+
+	c := &CliConfig{}
+	if err := json.Unmarshal(buffer, c); err != nil {
+		return "", fmt.Errorf("failed to parse config.json: %w", err)
+	}
+
+	return c.Tenants[domain].ClientID, nil
+}
+
+// updateCliConfigFileTenant applies the update to the tenant in the auth0-cli config
+// file, preserving all the other settings. It does nothing if the tenant isn't there.
+func updateCliConfigFileTenant(domain string, update func(tenant map[string]interface{})) error {
+	info, err := os.Stat(cliConfigPath)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	buffer, err := os.ReadFile(path.Clean(cliConfigPath))
+	if err != nil {
+		return fmt.Errorf("failed to read config.json: %w", err)
+	}
+
+	var config map[string]interface{}
+	decoder := json.NewDecoder(bytes.NewReader(buffer))
+	decoder.UseNumber()
+	if err := decoder.Decode(&config); err != nil {
+		return fmt.Errorf("failed to parse config.json: %w", err)
+	}
+
+	tenants, _ := config["tenants"].(map[string]interface{})
+	tenant, ok := tenants[domain].(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	update(tenant)
+
+	buffer, err = json.MarshalIndent(config, "", "    ")
+	if err != nil {
+		return err
+	}
+
+	if err := os.WriteFile(cliConfigPath, buffer, info.Mode().Perm()); err != nil {
+		return fmt.Errorf("failed to write config.json: %w", err)
+	}
+
+	return nil
+}
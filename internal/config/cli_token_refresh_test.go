@@ -0,0 +1,246 @@
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zalando/go-keyring"
+)
+
+const cliTestDomain = "example-cli.auth0.com"
+
+func setUpCLITokenRefresh(t *testing.T, tenantConfig, accessToken string) *[]map[string]string {
+	t.Helper()
+
+	keyring.MockInit()
+
+	configPath := filepath.Join(t.TempDir(), "config.json")
+	require.NoError(t, os.WriteFile(configPath, []byte(`{
+  "default_tenant": "`+cliTestDomain+`",
+  "tenants": {
+    "`+cliTestDomain+`": `+tenantConfig+`
+  }
+}`), 0o600))
+
+	originalConfigPath := cliConfigPath
+	SetCliConfigPath(configPath)
+
+	var requests []map[string]string
+	testServer := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		require.NoError(t, request.ParseForm())
+
+		form := map[string]string{}
+		for key := range request.PostForm {
+			form[key] = request.PostForm.Get(key)
+		}
+		requests = append(requests, form)
+
+		writer.Header().Set("Content-Type", "application/json")
+		_, _ = fmt.Fprintf(writer, `{"access_token":%q,"expires_in":86400,"token_type":"Bearer"}`, accessToken)
+	}))
+
+	originalEndpoint := cliTokenEndpoint
+	cliTokenEndpoint = func(_ string, _ bool) string {
+		return testServer.URL + "/oauth/token"
+	}
+
+	t.Cleanup(func() {
+		testServer.Close()
+		cliTokenEndpoint = originalEndpoint
+		SetCliConfigPath(originalConfigPath)
+	})
+
+	return &requests
+}
+
+func readCLIKeyringToken(t *testing.T) string {
+	t.Helper()
+
+	var token string
+	for i := 0; i < secretAccessTokenMaxChunks; i++ {
+		chunk, err := keyring.Get(fmt.Sprintf("%s %d", secretAccessToken, i), cliTestDomain)
+		if err != nil {
+			break
+		}
+		token += chunk
+	}
+
+	return token
+}
+
+func TestFetchAndValidateCLIToken_Refresh(t *testing.T) {
+	t.Run("it refreshes an expired token using the stored refresh token", func(t *testing.T) {
+		refreshedToken := createJwtToken(t, 24*time.Hour)
+		requests := setUpCLITokenRefresh(t, `{"name": "example-cli", "expires_at": "2020-01-01T00:00:00Z"}`, refreshedToken)
+
+		require.NoError(t, keyring.Set(secretAccessToken+" 0", cliTestDomain, createJwtToken(t, -time.Hour)))
+		require.NoError(t, keyring.Set(secretRefreshToken, cliTestDomain, "refresh-token"))
+
+		token, diags := fetchAndValidateCLIToken(cliTestDomain, http.DefaultClient)
+		require.Nil(t, diags)
+
+		assert.Equal(t, refreshedToken, token)
+		assert.Equal(t, []map[string]string{{
+			"grant_type":    "refresh_token",
+			"client_id":     cliClientID,
+			"refresh_token": "refresh-token",
+		}}, *requests)
+
+		assert.Equal(t, refreshedToken, readCLIKeyringToken(t))
+
+		var cliConfig CliConfig
+		buffer, err := os.ReadFile(cliConfigPath)
+		require.NoError(t, err)
+		require.NoError(t, json.Unmarshal(buffer, &cliConfig))
+		assert.WithinDuration(t, time.Now().Add(24*time.Hour), cliConfig.Tenants[cliTestDomain].ExpiresAt, time.Minute)
+		assert.Contains(t, string(buffer), `"default_tenant": "example-cli.auth0.com"`)
+	})
+
+	t.Run("it refreshes a token about to expire", func(t *testing.T) {
+		refreshedToken := createJwtToken(t, 24*time.Hour)
+		requests := setUpCLITokenRefresh(t, `{"name": "example-cli"}`, refreshedToken)
+
+		require.NoError(t, keyring.Set(secretAccessToken+" 0", cliTestDomain, createJwtToken(t, time.Minute)))
+		require.NoError(t, keyring.Set(secretRefreshToken, cliTestDomain, "refresh-token"))
+
+		token, diags := fetchAndValidateCLIToken(cliTestDomain, http.DefaultClient)
+		require.Nil(t, diags)
+
+		assert.Equal(t, refreshedToken, token)
+		assert.Len(t, *requests, 1)
+	})
+
+	t.Run("it refreshes the token using the stored client credentials", func(t *testing.T) {
+		refreshedToken := createJwtToken(t, 24*time.Hour)
+		requests := setUpCLITokenRefresh(t, `{"name": "example-cli", "client_id": "cli-client-id"}`, refreshedToken)
+
+		require.NoError(t, keyring.Set(secretAccessToken+" 0", cliTestDomain, createJwtToken(t, -time.Hour)))
+		require.NoError(t, keyring.Set(secretClientSecret, cliTestDomain, "client-secret"))
+
+		token, diags := fetchAndValidateCLIToken(cliTestDomain, http.DefaultClient)
+		require.Nil(t, diags)
+
+		assert.Equal(t, refreshedToken, token)
+		assert.Equal(t, []map[string]string{{
+			"grant_type":    "client_credentials",
+			"client_id":     "cli-client-id",
+			"client_secret": "client-secret",
+			"audience":      "https://" + cliTestDomain + "/api/v2/",
+		}}, *requests)
+	})
+
+	t.Run("it stores long tokens in chunks", func(t *testing.T) {
+		refreshedToken := createJwtToken(t, 24*time.Hour) + strings.Repeat("a", 5000)
+		setUpCLITokenRefresh(t, `{"name": "example-cli"}`, refreshedToken)
+
+		require.NoError(t, keyring.Set(secretAccessToken+" 0", cliTestDomain, createJwtToken(t, -time.Hour)))
+		require.NoError(t, keyring.Set(secretAccessToken+" 1", cliTestDomain, "stale-chunk"))
+		require.NoError(t, keyring.Set(secretRefreshToken, cliTestDomain, "refresh-token"))
+
+		_, err := refreshCLIToken(cliTestDomain, http.DefaultClient)
+		require.NoError(t, err)
+
+		chunk, err := keyring.Get(secretAccessToken+" 0", cliTestDomain)
+		require.NoError(t, err)
+		assert.Len(t, chunk, secretAccessTokenChunkSize)
+		assert.Equal(t, refreshedToken, readCLIKeyringToken(t))
+	})
+
+	t.Run("it doesn't leave a truncated token in the keyring when writing a chunk fails", func(t *testing.T) {
+		refreshedToken := createJwtToken(t, 24*time.Hour) + strings.Repeat("a", 5000)
+		setUpCLITokenRefresh(t, `{"name": "example-cli"}`, refreshedToken)
+
+		previousToken := createJwtToken(t, -time.Hour) + strings.Repeat("b", 5000)
+		require.NoError(t, storeCLITokenInKeyring(cliTestDomain, previousToken))
+		require.NoError(t, keyring.Set(secretRefreshToken, cliTestDomain, "refresh-token"))
+
+		originalSetKeyringSecret := setKeyringSecret
+		setKeyringSecret = func(service, user, password string) error {
+			if service == secretAccessToken+" 1" {
+				return keyring.ErrSetDataTooBig
+			}
+			return originalSetKeyringSecret(service, user, password)
+		}
+		t.Cleanup(func() {
+			setKeyringSecret = originalSetKeyringSecret
+		})
+
+		token, err := refreshCLIToken(cliTestDomain, http.DefaultClient)
+		require.NoError(t, err)
+		assert.Equal(t, refreshedToken, token.AccessToken)
+
+		assert.Empty(t, readCLIKeyringToken(t))
+
+		storedToken, err := getAccessTokenFromCliConfigFile(cliTestDomain)
+		require.NoError(t, err)
+		assert.Equal(t, refreshedToken, storedToken)
+	})
+
+	t.Run("it refreshes the token during the run once it nears expiry", func(t *testing.T) {
+		refreshedToken := createJwtToken(t, 24*time.Hour)
+		requests := setUpCLITokenRefresh(t, `{"name": "example-cli"}`, refreshedToken)
+		require.NoError(t, keyring.Set(secretRefreshToken, cliTestDomain, "refresh-token"))
+
+		// A token that is still valid at configure time is kept at first.
+		storedToken := createJwtToken(t, cliTokenRefreshMargin+2*time.Second)
+		source := newCLITokenSource(cliTestDomain, storedToken, http.DefaultClient)
+
+		token, err := source.Token()
+		require.NoError(t, err)
+		assert.Equal(t, storedToken, token.AccessToken)
+		assert.Empty(t, *requests)
+
+		// It is refreshed once it gets within the refresh margin of its expiry.
+		time.Sleep(2100 * time.Millisecond)
+
+		token, err = source.Token()
+		require.NoError(t, err)
+		assert.Equal(t, refreshedToken, token.AccessToken)
+		assert.Len(t, *requests, 1)
+	})
+
+	t.Run("it writes the token to the config file when it was stored there", func(t *testing.T) {
+		refreshedToken := createJwtToken(t, 24*time.Hour)
+		expiredToken := createJwtToken(t, -time.Hour)
+		setUpCLITokenRefresh(t, `{"name": "example-cli", "access_token": "`+expiredToken+`"}`, refreshedToken)
+		keyring.MockInitWithError(keyring.ErrUnsupportedPlatform)
+
+		require.NoError(t, storeCLIToken(cliTestDomain, refreshedToken, time.Now().Add(time.Hour)))
+
+		token, err := getAccessTokenFromCliConfigFile(cliTestDomain)
+		require.NoError(t, err)
+		assert.Equal(t, refreshedToken, token)
+	})
+
+	t.Run("it returns an error when the token expired and cannot be refreshed", func(t *testing.T) {
+		requests := setUpCLITokenRefresh(t, `{"name": "example-cli"}`, "")
+
+		require.NoError(t, keyring.Set(secretAccessToken+" 0", cliTestDomain, createJwtToken(t, -time.Hour)))
+
+		_, diags := fetchAndValidateCLIToken(cliTestDomain, http.DefaultClient)
+		require.Len(t, diags, 1)
+		assert.Equal(t, "Token validation failed", diags[0].Summary)
+		assert.Contains(t, diags[0].Detail, "could not be refreshed")
+		assert.Empty(t, *requests)
+	})
+
+	t.Run("it keeps using a token about to expire when it cannot be refreshed", func(t *testing.T) {
+		setUpCLITokenRefresh(t, `{"name": "example-cli"}`, "")
+
+		currentToken := createJwtToken(t, time.Minute)
+		require.NoError(t, keyring.Set(secretAccessToken+" 0", cliTestDomain, currentToken))
+
+		token, diags := fetchAndValidateCLIToken(cliTestDomain, http.DefaultClient)
+		require.Nil(t, diags)
+		assert.Equal(t, currentToken, token)
+	})
+}
@@ -0,0 +1,39 @@
+package config
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// ClientCredentials are the credentials of a client used to get
+// an access token for an API with the client credentials grant.
+type ClientCredentials struct {
+	ClientID string
+	// ClientSecret is used unless the ClientAssertionPrivateKey is set,
+	// in which case the client authenticates with a signed JWT (private_key_jwt).
+	ClientSecret              string
+	ClientAssertionPrivateKey string
+	ClientAssertionSigningAlg string
+	// Audience is the identifier of the API the access token is requested for.
+	Audience string
+}
+
+// ClientCredentialsToken gets an access token for the given client credentials from the token
+// endpoint of the tenant the provider is configured with, returning the token and its expiry.
+// The request goes through the same HTTP client as the Management API requests.
+func (c *Config) ClientCredentialsToken(ctx context.Context, credentials ClientCredentials) (string, time.Time, error) {
+	cfg := c.providerConfig
+	cfg.ClientID = credentials.ClientID
+	cfg.ClientSecret = credentials.ClientSecret
+	cfg.ClientAssertionPrivateKey = credentials.ClientAssertionPrivateKey
+	cfg.ClientAssertionSigningAlg = credentials.ClientAssertionSigningAlg
+	cfg.Audience = credentials.Audience
+
+	token, err := mintClientCredentialsToken(ctx, cfg)
+	if err != nil {
+		return "", time.Time{}, fmt.Errorf("failed to get an access token using the client credentials: %w", err)
+	}
+
+	return token.AccessToken, token.Expiry, nil
+}
@@ -0,0 +1,128 @@
+package config
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConfig_ClientCredentialsToken(t *testing.T) {
+	accessToken := createJwtToken(t, time.Hour)
+	responseAccessToken := accessToken
+
+	var forms []url.Values
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/jwks.json", func(writer http.ResponseWriter, _ *http.Request) {
+		writer.Header().Set("Content-Type", "application/json")
+		_, _ = writer.Write([]byte(`{"keys":[]}`))
+	})
+	mux.HandleFunc("/oauth/token", func(writer http.ResponseWriter, request *http.Request) {
+		require.NoError(t, request.ParseForm())
+		forms = append(forms, request.PostForm)
+
+		writer.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(writer).Encode(map[string]interface{}{
+			"access_token": responseAccessToken,
+			"token_type":   "Bearer",
+			"expires_in":   3600,
+		})
+	})
+
+	testServer := httptest.NewTLSServer(mux)
+	t.Cleanup(testServer.Close)
+
+	baseTransport, err := newBaseTransport(TransportConfig{CABundlePEM: certificatePEM(testServer.Certificate())})
+	require.NoError(t, err)
+
+	providerConfig := New(nil)
+	providerConfig.providerConfig = ProviderConfig{
+		Domain:       testServer.Listener.Addr().String(),
+		ClientID:     "provider-client-id",
+		ClientSecret: "provider-client-secret",
+		Audience:     "https://example.auth0.com/api/v2/",
+		Retry:        RetryPolicy{MaxAttempts: 1},
+
+		baseTransport: baseTransport,
+	}
+
+	privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	privateKeyPEM := pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(privateKey)})
+
+	t.Run("it authenticates with the client secret", func(t *testing.T) {
+		forms = nil
+
+		token, expiresAt, err := providerConfig.ClientCredentialsToken(context.Background(), ClientCredentials{
+			ClientID:     "client-id",
+			ClientSecret: "client-secret",
+			Audience:     "https://api.example.com",
+		})
+		require.NoError(t, err)
+
+		assert.Equal(t, accessToken, token)
+		assert.WithinDuration(t, time.Now().Add(time.Hour), expiresAt, time.Minute)
+
+		require.Len(t, forms, 1)
+		assert.Equal(t, "client_credentials", forms[0].Get("grant_type"))
+		assert.Equal(t, "client-id", forms[0].Get("client_id"))
+		assert.Equal(t, "client-secret", forms[0].Get("client_secret"))
+		assert.Equal(t, "https://api.example.com", forms[0].Get("audience"))
+	})
+
+	t.Run("it authenticates with a signed client assertion", func(t *testing.T) {
+		forms = nil
+
+		token, _, err := providerConfig.ClientCredentialsToken(context.Background(), ClientCredentials{
+			ClientID:                  "client-id",
+			ClientAssertionPrivateKey: string(privateKeyPEM),
+			ClientAssertionSigningAlg: "RS256",
+			Audience:                  "https://api.example.com",
+		})
+		require.NoError(t, err)
+
+		assert.Equal(t, accessToken, token)
+
+		require.Len(t, forms, 1)
+		assert.Empty(t, forms[0].Get("client_secret"))
+		assert.Equal(t, "urn:ietf:params:oauth:client-assertion-type:jwt-bearer", forms[0].Get("client_assertion_type"))
+		assert.NotEmpty(t, forms[0].Get("client_assertion"))
+	})
+	t.Run("it takes the expiry of opaque tokens from the token response", func(t *testing.T) {
+		responseAccessToken = "opaque-access-token"
+		t.Cleanup(func() { responseAccessToken = accessToken })
+
+		token, expiresAt, err := providerConfig.ClientCredentialsToken(context.Background(), ClientCredentials{
+			ClientID:     "client-id",
+			ClientSecret: "client-secret",
+			Audience:     "https://api.example.com",
+		})
+		require.NoError(t, err)
+
+		assert.Equal(t, "opaque-access-token", token)
+		assert.WithinDuration(t, time.Now().Add(time.Hour), expiresAt, time.Minute)
+	})
+}
+
+func TestAccessTokenExpiry(t *testing.T) {
+	expiresAt, err := accessTokenExpiry("opaque-access-token", 600)
+	require.NoError(t, err)
+	assert.WithinDuration(t, time.Now().Add(10*time.Minute), expiresAt, time.Minute)
+
+	expiresAt, err = accessTokenExpiry(createJwtToken(t, 2*time.Hour), 0)
+	require.NoError(t, err)
+	assert.WithinDuration(t, time.Now().Add(2*time.Hour), expiresAt, time.Minute)
+
+	_, err = accessTokenExpiry("opaque-access-token", 0)
+	assert.Error(t, err)
+}
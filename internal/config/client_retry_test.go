@@ -33,7 +33,7 @@ func TestCustomClientWithRetries(t *testing.T) {
 			writer.WriteHeader(200)
 		}))
 
-		client := customClientWithRetries()
+		client := customClientWithRetries(ProviderConfig{})
 
 		request, err := http.NewRequest(http.MethodGet, testServer.URL, nil)
 		require.NoError(t, err)
@@ -67,7 +67,7 @@ func TestCustomClientWithRetries(t *testing.T) {
 			writer.WriteHeader(200)
 		}))
 
-		client := customClientWithRetries()
+		client := customClientWithRetries(ProviderConfig{})
 
 		request, err := http.NewRequest(http.MethodGet, testServer.URL, nil)
 		require.NoError(t, err)
@@ -93,7 +93,7 @@ func TestCustomClientWithRetries(t *testing.T) {
 			writer.WriteHeader(500)
 		}))
 
-		client := customClientWithRetries()
+		client := customClientWithRetries(ProviderConfig{})
 
 		request, err := http.NewRequest(http.MethodGet, testServer.URL, nil)
 		require.NoError(t, err)
@@ -112,6 +112,109 @@ func TestCustomClientWithRetries(t *testing.T) {
 	})
 }
 
+func TestCustomClientWithRetryPolicy(t *testing.T) {
+	t.Run("it does not retry when max attempts is 1", func(t *testing.T) {
+		apiCalls := 0
+		testServer := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, _ *http.Request) {
+			apiCalls++
+			writer.WriteHeader(500)
+		}))
+
+		client := customClientWithRetries(ProviderConfig{Retry: RetryPolicy{MaxAttempts: 1}})
+
+		request, err := http.NewRequest(http.MethodGet, testServer.URL, nil)
+		require.NoError(t, err)
+
+		response, err := client.Do(request)
+		require.NoError(t, err)
+
+		assert.Equal(t, 500, response.StatusCode)
+		assert.Equal(t, 1, apiCalls)
+
+		t.Cleanup(func() {
+			testServer.Close()
+			err := response.Body.Close()
+			require.NoError(t, err)
+		})
+	})
+
+	t.Run("it only retries on the configured statuses", func(t *testing.T) {
+		apiCalls := 0
+		testServer := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, _ *http.Request) {
+			apiCalls++
+			if apiCalls == 1 {
+				writer.WriteHeader(409)
+				return
+			}
+			writer.WriteHeader(500)
+		}))
+
+		client := customClientWithRetries(ProviderConfig{
+			Retry: RetryPolicy{
+				MaxAttempts:     3,
+				MinBackoff:      time.Millisecond,
+				MaxBackoff:      time.Millisecond,
+				RetryOnStatuses: []int{409},
+			},
+		})
+
+		request, err := http.NewRequest(http.MethodGet, testServer.URL, nil)
+		require.NoError(t, err)
+
+		response, err := client.Do(request)
+		require.NoError(t, err)
+
+		assert.Equal(t, 500, response.StatusCode)
+		assert.Equal(t, 2, apiCalls)
+
+		t.Cleanup(func() {
+			testServer.Close()
+			err := response.Body.Close()
+			require.NoError(t, err)
+		})
+	})
+
+	t.Run("it fails fast when the rate limit reset exceeds the max wait", func(t *testing.T) {
+		apiCalls := 0
+		testServer := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, _ *http.Request) {
+			apiCalls++
+			resetAt := time.Now().Add(time.Minute).Unix()
+			writer.Header().Set("X-RateLimit-Reset", strconv.Itoa(int(resetAt)))
+			writer.WriteHeader(429)
+		}))
+
+		client := customClientWithRetries(ProviderConfig{Retry: RetryPolicy{MaxRateLimitWait: time.Second}})
+
+		request, err := http.NewRequest(http.MethodGet, testServer.URL, nil)
+		require.NoError(t, err)
+
+		response, err := client.Do(request)
+		require.NoError(t, err)
+
+		assert.Equal(t, 429, response.StatusCode)
+		assert.Equal(t, 1, apiCalls)
+
+		t.Cleanup(func() {
+			testServer.Close()
+			err := response.Body.Close()
+			require.NoError(t, err)
+		})
+	})
+}
+
+func TestRetryPolicyWithDefaults(t *testing.T) {
+	policy := RetryPolicy{}.withDefaults()
+
+	assert.Equal(t, 4, policy.MaxAttempts)
+	assert.Equal(t, 500*time.Millisecond, policy.MinBackoff)
+	assert.Equal(t, 10*time.Second, policy.MaxBackoff)
+	assert.Equal(t, defaultRetryOnStatuses, policy.RetryOnStatuses)
+	assert.Equal(t, time.Duration(0), policy.MaxRateLimitWait)
+
+	policy = RetryPolicy{MinBackoff: time.Minute, MaxBackoff: time.Second}.withDefaults()
+	assert.Equal(t, time.Minute, policy.MaxBackoff)
+}
+
 func TestRetryableErrorRetryFunc(t *testing.T) {
 	testCases := []struct {
 		name     string
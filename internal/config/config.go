@@ -13,6 +13,7 @@ import (
 	"os"
 	"path"
 	"regexp"
+	"sort"
 	"strconv"
 	"strings"
 	"sync"
@@ -28,6 +29,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/meta"
 	"github.com/zalando/go-keyring"
+	"golang.org/x/oauth2"
 
 	"github.com/auth0/terraform-provider-auth0/internal/mutex"
 )
@@ -54,15 +56,19 @@ type CliConfig struct {
 	Tenants map[string]struct {
 		AccessToken string    `json:"access_token,omitempty"`
 		ExpiresAt   time.Time `json:"expires_at"`
+		ClientID    string    `json:"client_id,omitempty"`
 	} `json:"tenants"`
 }
 
 // Config is the type used for the
 // *schema.Provider meta parameter.
 type Config struct {
-	api   *management.Management
-	apiv3 *managementv3.Management
-	mutex *mutex.KeyValue
+	api             *management.Management
+	apiv3           *managementv3.Management
+	mutex           *mutex.KeyValue
+	defaultMetadata DefaultMetadata
+	adoptExisting   bool
+	providerConfig  ProviderConfig
 }
 
 // New instantiates a new Config.
@@ -97,6 +103,18 @@ func (c *Config) GetMutex() *mutex.KeyValue {
 	return c.mutex
 }
 
+// GetDefaultMetadata fetches the metadata added to the
+// clients, organizations and connections managed by the provider.
+func (c *Config) GetDefaultMetadata() DefaultMetadata {
+	return c.defaultMetadata
+}
+
+// GetAdoptExisting reports whether creating an object that
+// already exists adopts it instead of failing with a conflict.
+func (c *Config) GetAdoptExisting() bool {
+	return c.adoptExisting
+}
+
 // ProviderConfig holds the loaded provider configuration values.
 type ProviderConfig struct {
 	Debug                     bool
@@ -108,6 +126,77 @@ type ProviderConfig struct {
 	ClientAssertionPrivateKey string
 	ClientAssertionSigningAlg string
 	CustomDomainHeader        string
+	Retry                     RetryPolicy
+	CacheGetRequests          bool
+	TokenCachePath            string
+	CredentialProcess         string
+	ReadOnly                  bool
+	AdoptExisting             bool
+	AuditLogPath              string
+	DebugRedaction            DebugRedaction
+	Transport                 TransportConfig
+	DefaultMetadata           DefaultMetadata
+
+	// credentialProcess is the running credential process, set when configuring the provider.
+	credentialProcess *credentialProcess
+	// baseTransport sends the requests to Auth0, set when parsing the provider configuration.
+	baseTransport http.RoundTripper
+	// rateLimitPacers paces the requests of the v1 and v3 clients, set when configuring the provider.
+	rateLimitPacers *rateLimitPacers
+	// tokenSource refreshes the access token sent by the SDKs, set when configuring the provider.
+	tokenSource oauth2.TokenSource
+	// responseCache caches the GET responses of the v1 and v3 clients, set when configuring the provider.
+	responseCache *responseCache
+}
+
+// RetryPolicy holds the retry settings of the Management API HTTP client.
+// Zero values fall back to the provider defaults.
+type RetryPolicy struct {
+	// MaxAttempts is the maximum number of attempts made for a
+	// request failing with a retryable error, including the first one.
+	MaxAttempts int
+	// MinBackoff and MaxBackoff bound the exponential
+	// jitter delay between retries of failed requests.
+	MinBackoff time.Duration
+	MaxBackoff time.Duration
+	// RetryOnStatuses lists the HTTP status codes that trigger a retry.
+	RetryOnStatuses []int
+	// MaxRateLimitWait is the longest the client waits for a rate limit to
+	// reset before retrying a 429. A zero value waits for as long as needed.
+	MaxRateLimitWait time.Duration
+}
+
+// defaultRetryOnStatuses lists the status codes retried when the provider is not configured otherwise.
+var defaultRetryOnStatuses = []int{
+	http.StatusServiceUnavailable,
+	http.StatusInternalServerError,
+	http.StatusBadGateway,
+	http.StatusGatewayTimeout,
+	// Cloudflare-specific server error that is generated
+	// because Cloudflare did not receive an HTTP response
+	// from the origin server after an HTTP Connection was made.
+	524,
+}
+
+// withDefaults returns a copy of the policy with all unset values replaced by their defaults.
+func (p RetryPolicy) withDefaults() RetryPolicy {
+	if p.MaxAttempts <= 0 {
+		p.MaxAttempts = 4
+	}
+	if p.MinBackoff <= 0 {
+		p.MinBackoff = 500 * time.Millisecond
+	}
+	if p.MaxBackoff <= 0 {
+		p.MaxBackoff = 10 * time.Second
+	}
+	if p.MaxBackoff < p.MinBackoff {
+		p.MaxBackoff = p.MinBackoff
+	}
+	if len(p.RetryOnStatuses) == 0 {
+		p.RetryOnStatuses = defaultRetryOnStatuses
+	}
+
+	return p
 }
 
 // ParseResourceConfigData parses the *schema.ResourceData.
@@ -122,7 +211,38 @@ func ParseResourceConfigData(data *schema.ResourceData) (ProviderConfig, diag.Di
 		ClientAssertionPrivateKey: data.Get("client_assertion_private_key").(string),
 		ClientAssertionSigningAlg: data.Get("client_assertion_signing_alg").(string),
 		CustomDomainHeader:        data.Get("custom_domain_header").(string),
+		CacheGetRequests:          data.Get("cache_get_requests").(bool),
+		TokenCachePath:            data.Get("token_cache_path").(string),
+		CredentialProcess:         data.Get("credential_process").(string),
+		ReadOnly:                  data.Get("read_only").(bool),
+		AdoptExisting:             data.Get("adopt_existing").(bool),
+		AuditLogPath:              data.Get("audit_log_path").(string),
+		Transport: TransportConfig{
+			ProxyURL:          data.Get("proxy_url").(string),
+			CABundlePEM:       data.Get("ca_bundle_pem").(string),
+			CABundleFile:      data.Get("ca_bundle_file").(string),
+			ClientCertificate: data.Get("client_certificate").(string),
+			ClientKey:         data.Get("client_key").(string),
+		},
+	}
+
+	retry, diags := expandRetryPolicy(data)
+	if diags != nil {
+		return ProviderConfig{}, diags
 	}
+	cfg.Retry = retry
+	cfg.DebugRedaction = expandDebugRedaction(data)
+	cfg.DefaultMetadata = expandDefaultMetadata(data)
+
+	baseTransport, err := newBaseTransport(cfg.Transport)
+	if err != nil {
+		return ProviderConfig{}, diag.Diagnostics{{
+			Severity: diag.Error,
+			Summary:  "Invalid network configuration",
+			Detail:   err.Error(),
+		}}
+	}
+	cfg.baseTransport = baseTransport
 
 	dynamicCredentials := data.Get("dynamic_credentials").(bool)
 	cliLogin := data.Get("cli_login").(bool)
@@ -148,13 +268,21 @@ func ParseResourceConfigData(data *schema.ResourceData) (ProviderConfig, diag.Di
 		}
 
 		// Fetch and validate CLI token.
-		tempToken, diags := fetchAndValidateCLIToken(cfg.Domain)
+		httpClient := &http.Client{Transport: baseTransport}
+		tempToken, diags := fetchAndValidateCLIToken(cfg.Domain, httpClient)
 		if diags != nil {
 			return ProviderConfig{}, diags
 		}
 
-		// Set the apiToken to the valid tempToken.
+		// Set the apiToken to the valid tempToken, which
+		// gets refreshed during the run once it nears expiry.
 		cfg.APIToken = tempToken
+		cfg.tokenSource = newCLITokenSource(cfg.Domain, tempToken, httpClient)
+
+	case cfg.CredentialProcess != "":
+		if cfg.Domain == "" {
+			return ProviderConfig{}, missingDomain("'AUTH0_CREDENTIAL_PROCESS'")
+		}
 
 	case cfg.APIToken != "":
 		if cfg.Domain == "" {
@@ -192,31 +320,159 @@ func ParseResourceConfigData(data *schema.ResourceData) (ProviderConfig, diag.Di
 			Detail: "AUTH0_DOMAIN is required. Then, configure either AUTH0_API_TOKEN, " +
 				"or AUTH0_CLIENT_ID and AUTH0_CLIENT_SECRET, " +
 				"or AUTH0_CLIENT_ID, AUTH0_CLIENT_ASSERTION_PRIVATE_KEY, and AUTH0_CLIENT_ASSERTION_SIGNING_ALG, " +
-				"or enable CLI login with AUTH0_CLI_LOGIN=true.",
+				"or enable CLI login with AUTH0_CLI_LOGIN=true, " +
+				"or configure a credential process with AUTH0_CREDENTIAL_PROCESS.",
 		}}
 	}
 
 	return cfg, nil
 }
 
+// expandRetryPolicy parses the optional `retry` block of the provider configuration.
+func expandRetryPolicy(data *schema.ResourceData) (RetryPolicy, diag.Diagnostics) {
+	var policy RetryPolicy
+
+	retryList, _ := data.Get("retry").([]interface{})
+	if len(retryList) == 0 || retryList[0] == nil {
+		return policy, nil
+	}
+
+	retry := retryList[0].(map[string]interface{})
+
+	policy.MaxAttempts, _ = retry["max_attempts"].(int)
+
+	durations := map[string]*time.Duration{
+		"min_backoff":         &policy.MinBackoff,
+		"max_backoff":         &policy.MaxBackoff,
+		"max_rate_limit_wait": &policy.MaxRateLimitWait,
+	}
+	for key, target := range durations {
+		value, _ := retry[key].(string)
+		if value == "" {
+			continue
+		}
+
+		duration, err := time.ParseDuration(value)
+		if err != nil {
+			return RetryPolicy{}, diag.Diagnostics{{
+				Severity: diag.Error,
+				Summary:  "Invalid retry configuration",
+				Detail:   fmt.Sprintf("The 'retry.%s' value %q is not a valid duration: %s.", key, value, err),
+			}}
+		}
+
+		*target = duration
+	}
+
+	if statuses, ok := retry["retry_on_statuses"].(*schema.Set); ok {
+		for _, status := range statuses.List() {
+			policy.RetryOnStatuses = append(policy.RetryOnStatuses, status.(int))
+		}
+		sort.Ints(policy.RetryOnStatuses)
+	}
+
+	return policy, nil
+}
+
+// expandDebugRedaction parses the optional `debug_redaction` block of the provider configuration.
+func expandDebugRedaction(data *schema.ResourceData) DebugRedaction {
+	var redaction DebugRedaction
+
+	redactionList, _ := data.Get("debug_redaction").([]interface{})
+	if len(redactionList) == 0 || redactionList[0] == nil {
+		return redaction
+	}
+
+	rules := redactionList[0].(map[string]interface{})
+
+	for _, path := range rules["json_paths"].([]interface{}) {
+		redaction.JSONPaths = append(redaction.JSONPaths, path.(string))
+	}
+	for _, header := range rules["headers"].([]interface{}) {
+		redaction.Headers = append(redaction.Headers, header.(string))
+	}
+
+	return redaction
+}
+
+// expandDefaultMetadata parses the optional `default_metadata` block of the provider configuration.
+func expandDefaultMetadata(data *schema.ResourceData) DefaultMetadata {
+	defaultMetadataList, _ := data.Get("default_metadata").([]interface{})
+	if len(defaultMetadataList) == 0 || defaultMetadataList[0] == nil {
+		return nil
+	}
+
+	metadata, _ := defaultMetadataList[0].(map[string]interface{})["metadata"].(map[string]interface{})
+	if len(metadata) == 0 {
+		return nil
+	}
+
+	defaultMetadata := make(DefaultMetadata, len(metadata))
+	for key, value := range metadata {
+		defaultMetadata[key] = value.(string)
+	}
+
+	return defaultMetadata
+}
+
 // ConfigureProvider will configure the *schema.Provider so that
 // *management.Management client and *mutex.KeyValue is stored
 // and passed into the subsequent resources as the meta parameter.
 func ConfigureProvider(terraformVersion *string) schema.ConfigureContextFunc {
-	return func(_ context.Context, data *schema.ResourceData) (interface{}, diag.Diagnostics) {
+	return func(ctx context.Context, data *schema.ResourceData) (interface{}, diag.Diagnostics) {
 		config, d := ParseResourceConfigData(data)
 		if d != nil {
 			return nil, d
 		}
 
+		config.rateLimitPacers = newRateLimitPacers()
+		config.responseCache = newResponseCache()
+
+		if config.AuditLogPath != "" {
+			// Fail early rather than apply changes that can't be audited.
+			file, err := openAuditLog(config.AuditLogPath)
+			if err != nil {
+				return nil, diag.FromErr(err)
+			}
+			_ = file.Close()
+		}
+
+		if config.CredentialProcess != "" {
+			process, err := newCredentialProcess(config.CredentialProcess)
+			if err != nil {
+				return nil, diag.FromErr(err)
+			}
+
+			credentials, err := process.credentials(ctx)
+			if err != nil {
+				return nil, diag.FromErr(err)
+			}
+
+			credentials.applyTo(&config)
+			config.credentialProcess = process
+		}
+
+		if config.TokenCachePath != "" && config.APIToken == "" && config.ClientID != "" {
+			tokenSource := newTokenCacheSource(ctx, config)
+
+			token, err := tokenSource.Token()
+			if err != nil {
+				return nil, diag.FromErr(err)
+			}
+
+			// The SDKs start with the cached token, and the token
+			// source replaces it with a fresh one once it nears expiry.
+			config.APIToken = token.AccessToken
+			config.tokenSource = tokenSource
+		}
+
 		apiClient, err := management.New(config.Domain,
 			authenticationOption(config),
 			management.WithUserAgent(userAgent(terraformVersion)),
 			management.WithAuth0ClientEnvEntry(providerName, version),
 			management.WithNoRetries(),
-			management.WithClient(customClientWithRetries()),
-			management.WithCustomDomainHeader(config.CustomDomainHeader),
-			management.WithDebug(config.Debug))
+			management.WithClient(customClientWithRetries(config)),
+			management.WithCustomDomainHeader(config.CustomDomainHeader))
 
 		if err != nil {
 			return nil, diag.FromErr(err)
@@ -226,19 +482,23 @@ func ConfigureProvider(terraformVersion *string) schema.ConfigureContextFunc {
 			authenticationOptionV3(config),
 			option.WithUserAgent(userAgent(terraformVersion)),
 			option.WithAuth0ClientEnvEntry(providerName, version),
-			option.WithHTTPClient(customClientWithRetries()),
-			option.WithCustomDomainHeader(config.CustomDomainHeader),
-			option.WithDebug(config.Debug))
+			option.WithHTTPClient(customClientWithRetries(config)),
+			option.WithCustomDomainHeader(config.CustomDomainHeader))
 
 		if err != nil {
 			return nil, diag.FromErr(err)
 		}
 
-		return NewWithV3(apiClient, apiClientV3), nil
+		providerConfig := NewWithV3(apiClient, apiClientV3)
+		providerConfig.defaultMetadata = config.DefaultMetadata
+		providerConfig.adoptExisting = config.AdoptExisting
+		providerConfig.providerConfig = config
+
+		return providerConfig, nil
 	}
 }
 
-func fetchAndValidateCLIToken(domain string) (string, diag.Diagnostics) {
+func fetchAndValidateCLIToken(domain string, httpClient *http.Client) (string, diag.Diagnostics) {
 	var tempToken string
 	for i := 0; i < secretAccessTokenMaxChunks; i++ {
 		chunk, err := keyring.Get(fmt.Sprintf("%s %d", secretAccessToken, i), domain)
@@ -271,6 +531,27 @@ func fetchAndValidateCLIToken(domain string) (string, diag.Diagnostics) {
 		}}
 	}
 
+	// Refresh the token if it expired or is about to, using the
+	// refresh token or client credentials stored by the auth0-cli.
+	if expiresAt, err := tokenExpiresAt(tempToken); err == nil && time.Until(expiresAt) < cliTokenRefreshMargin {
+		refreshedToken, refreshErr := refreshCLIToken(domain, httpClient)
+		if refreshErr == nil {
+			return refreshedToken.AccessToken, nil
+		}
+
+		// A token that is about to expire is still usable.
+		if validateTokenExpiry(tempToken) == nil {
+			return tempToken, nil
+		}
+
+		return "", diag.Diagnostics{{
+			Severity: diag.Error,
+			Summary:  "Token validation failed",
+			Detail: fmt.Sprintf("expired token: the stored auth0-cli token has expired and could not be refreshed (%s). "+
+				"Please log in again", refreshErr),
+		}}
+	}
+
 	// Check if the token is expired.
 	if err := validateTokenExpiry(tempToken); err != nil {
 		return "", diag.Diagnostics{{
@@ -318,22 +599,33 @@ func decodeJWT(token string) (map[string]interface{}, map[string]interface{}, er
 }
 
 func validateTokenExpiry(tokenString string) error {
-	_, payload, err := decodeJWT(tokenString)
+	expiresAt, err := tokenExpiresAt(tokenString)
 	if err != nil {
 		return err
 	}
 
-	if exp, ok := payload["exp"].(float64); ok {
-		if time.Now().Unix() > int64(exp) {
-			return fmt.Errorf("expired token: the stored auth0-cli token has expired. Please log in again")
-		}
-	} else {
-		return fmt.Errorf("missing expiration: the token does not contain an expiration claim")
+	if time.Now().After(expiresAt) {
+		return fmt.Errorf("expired token: the stored auth0-cli token has expired. Please log in again")
 	}
 
 	return nil
 }
 
+// tokenExpiresAt returns the time at which the JWT expires, according to its exp claim.
+func tokenExpiresAt(tokenString string) (time.Time, error) {
+	_, payload, err := decodeJWT(tokenString)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	exp, ok := payload["exp"].(float64)
+	if !ok {
+		return time.Time{}, fmt.Errorf("missing expiration: the token does not contain an expiration claim")
+	}
+
+	return time.Unix(int64(exp), 0), nil
+}
+
 // userAgent computes the desired User-Agent header for the *management.Management client.
 func userAgent(terraformVersion *string) string {
 	sdkVersion := auth0.Version
@@ -353,7 +645,7 @@ func userAgent(terraformVersion *string) string {
 
 // authenticationOption computes the desired authentication option for the *management.Management client.
 func authenticationOption(cfg ProviderConfig) management.Option {
-	ctx := context.Background()
+	ctx := tokenEndpointContext(cfg)
 
 	switch {
 	case cfg.APIToken != "":
@@ -387,9 +679,15 @@ func authenticationOption(cfg ProviderConfig) management.Option {
 	}
 }
 
+// tokenEndpointContext returns the context the SDKs request access tokens with,
+// which makes them send the requests to the token endpoint through the provider's HTTP client.
+func tokenEndpointContext(cfg ProviderConfig) context.Context {
+	return context.WithValue(context.Background(), oauth2.HTTPClient, customClientWithRetries(cfg))
+}
+
 // authenticationOptionV3 computes the desired authentication option for the v3 management client.
 func authenticationOptionV3(cfg ProviderConfig) option.RequestOption {
-	ctx := context.Background()
+	ctx := tokenEndpointContext(cfg)
 
 	switch {
 	case cfg.APIToken != "":
@@ -433,6 +731,12 @@ type rateLimitMetrics struct {
 	maxRemaining      int
 	firstRequest      time.Time
 	lastRequest       time.Time
+
+	// totalPacingDuration is the time the pacer delayed requests to stay within the rate limit.
+	totalPacingDuration time.Duration
+
+	// endpoints breaks the usage down per method and endpoint template.
+	endpoints map[string]*endpointUsage
 }
 
 // Global metrics instance (per provider configuration).
@@ -486,12 +790,29 @@ func (m *rateLimitMetrics) logSummary(ctx context.Context) {
 			"total_requests":      m.totalRequests,
 			"rate_limit_hits":     m.rateLimitHits,
 			"total_wait_duration": m.totalWaitDuration.String(),
+			"pacing_duration":     m.totalPacingDuration.String(),
 			"min_remaining":       m.minRemaining,
 			"max_remaining":       m.maxRemaining,
 			"efficiency_score":    fmt.Sprintf("%.1f%%", efficiencyScore),
 			"execution_duration":  m.lastRequest.Sub(m.firstRequest).String(),
 		},
 	)
+
+	for _, endpoint := range m.endpointReports() {
+		tflog.Info(ctx, "Auth0 API Usage by Endpoint",
+			map[string]interface{}{
+				"method":          endpoint.Method,
+				"endpoint":        endpoint.Endpoint,
+				"count":           endpoint.Count,
+				"rate_limit_hits": endpoint.RateLimitHits,
+				"wait_ms":         endpoint.WaitMilliseconds,
+				"pacing_wait_ms":  endpoint.PacingWaitMilliseconds,
+				"latency_p50_ms":  endpoint.Latency.P50,
+				"latency_p90_ms":  endpoint.Latency.P90,
+				"latency_p99_ms":  endpoint.Latency.P99,
+			},
+		)
+	}
 }
 
 // rateLimitLoggingTransport wraps an http.RoundTripper to provide diagnostic
@@ -614,31 +935,95 @@ func newRateLimitLoggingTransport(tripper http.RoundTripper, metrics *rateLimitM
 	}
 }
 
-func customClientWithRetries() *http.Client {
-	client := &http.Client{
-		Transport: newRateLimitLoggingTransport(
-			rateLimitTransport(
-				retryableErrorTransport(
-					http.DefaultTransport,
+func customClientWithRetries(config ProviderConfig) *http.Client {
+	retry := config.Retry.withDefaults()
+
+	var baseTransport = http.DefaultTransport
+	if config.baseTransport != nil {
+		baseTransport = config.baseTransport
+	}
+
+	pacers := config.rateLimitPacers
+	if pacers == nil {
+		pacers = newRateLimitPacers()
+	}
+
+	if config.Debug {
+		// Log every attempt, including retries, as it is sent.
+		baseTransport = newDebugTransport(baseTransport, config.DebugRedaction)
+	}
+
+	if config.credentialProcess != nil {
+		// Refresh the access token on every attempt, including retries.
+		baseTransport = newCredentialProcessTransport(baseTransport, config.credentialProcess)
+	}
+
+	if config.tokenSource != nil {
+		// Refresh the access token on every attempt, including retries.
+		baseTransport = newTokenSourceTransport(baseTransport, config.tokenSource)
+	}
+
+	transport := newRateLimitLoggingTransport(
+		measuredRateLimitTransport(
+			retryableErrorTransport(
+				newRateLimitPacingTransport(
+					newAPIUsageTransport(baseTransport, globalMetrics), // Record every attempt, excluding pacing delays.
+					pacers,        // Pace every attempt, including retries.
+					globalMetrics, // Record the pacing delays.
 				),
+				retry,
 			),
-			globalMetrics, // Pass metrics tracker.
+			retry.MaxRateLimitWait,
+			globalMetrics, // Record the rate limit waits.
 		),
+		globalMetrics, // Pass metrics tracker.
+	)
+
+	if config.CacheGetRequests {
+		cache := config.responseCache
+		if cache == nil {
+			cache = newResponseCache()
+		}
+
+		// Cache hits never reach the API, so they are kept out of the rate limit metrics.
+		transport = newResponseCacheTransport(transport, cache)
+	}
+
+	if config.AuditLogPath != "" {
+		// Audit the outcome of each request once, after all of its retries.
+		transport = newAuditLogTransport(transport, config.AuditLogPath)
+	}
+
+	if config.ReadOnly {
+		// Reject mutations before they reach the retry logic, which would otherwise retry the error.
+		transport = newReadOnlyTransport(transport)
+	}
+
+	client := &http.Client{
+		Transport: transport,
 	}
 
 	return client
 }
 
-func rateLimitTransport(tripper http.RoundTripper) http.RoundTripper {
-	return rehttp.NewTransport(tripper, rateLimitRetry, rateLimitDelay)
+func rateLimitTransport(tripper http.RoundTripper, maxWait time.Duration) http.RoundTripper {
+	return rehttp.NewTransport(tripper, rateLimitRetry(maxWait), rateLimitDelay)
 }
 
-func rateLimitRetry(attempt rehttp.Attempt) bool {
-	if attempt.Response == nil {
-		return false
-	}
+// rateLimitRetry retries requests that hit the rate limit, unless
+// a non-zero maxWait is shorter than the time left until the reset.
+func rateLimitRetry(maxWait time.Duration) rehttp.RetryFn {
+	return func(attempt rehttp.Attempt) bool {
+		if attempt.Response == nil {
+			return false
+		}
 
-	return attempt.Response.StatusCode == http.StatusTooManyRequests
+		if attempt.Response.StatusCode != http.StatusTooManyRequests {
+			return false
+		}
+
+		return maxWait <= 0 || rateLimitDelay(attempt) <= maxWait
+	}
 }
 
 func rateLimitDelay(attempt rehttp.Attempt) time.Duration {
@@ -652,26 +1037,17 @@ func rateLimitDelay(attempt rehttp.Attempt) time.Duration {
 	return time.Duration(resetAtUnix-time.Now().Unix()) * time.Second
 }
 
-func retryableErrorTransport(tripper http.RoundTripper) http.RoundTripper {
+func retryableErrorTransport(tripper http.RoundTripper, retry RetryPolicy) http.RoundTripper {
 	return rehttp.NewTransport(
 		tripper,
 		rehttp.RetryAll(
-			rehttp.RetryMaxRetries(3),
+			rehttp.RetryMaxRetries(retry.MaxAttempts-1),
 			rehttp.RetryAny(
-				rehttp.RetryStatuses(
-					http.StatusServiceUnavailable,
-					http.StatusInternalServerError,
-					http.StatusBadGateway,
-					http.StatusGatewayTimeout,
-					// Cloudflare-specific server error that is generated
-					// because Cloudflare did not receive an HTTP response
-					// from the origin server after an HTTP Connection was made.
-					524,
-				),
+				rehttp.RetryStatuses(retry.RetryOnStatuses...),
 				rehttp.RetryIsErr(retryableErrorRetryFunc),
 			),
 		),
-		rehttp.ExpJitterDelay(500*time.Millisecond, 10*time.Second),
+		rehttp.ExpJitterDelay(retry.MinBackoff, retry.MaxBackoff),
 	)
 }
 
@@ -10,7 +10,6 @@ import (
 	"github.com/auth0/go-auth0/management"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/lestrrat-go/jwx/v2/jwt"
 	"github.com/stretchr/testify/assert"
 	"github.com/zalando/go-keyring"
 
@@ -84,7 +83,8 @@ func TestConfigureProvider(t *testing.T) {
 					Detail: "AUTH0_DOMAIN is required. Then, configure either AUTH0_API_TOKEN, " +
 						"or AUTH0_CLIENT_ID and AUTH0_CLIENT_SECRET, " +
 						"or AUTH0_CLIENT_ID, AUTH0_CLIENT_ASSERTION_PRIVATE_KEY, and AUTH0_CLIENT_ASSERTION_SIGNING_ALG, " +
-						"or enable CLI login with AUTH0_CLI_LOGIN=true.",
+						"or enable CLI login with AUTH0_CLI_LOGIN=true, " +
+						"or configure a credential process with AUTH0_CREDENTIAL_PROCESS.",
 				},
 			},
 		},
@@ -123,7 +123,7 @@ func TestConfigureProvider(t *testing.T) {
 }
 
 func TestParseResourceConfigData(t *testing.T) {
-	jwtToken := createJwtToken(t)
+	jwtToken := config.CreateJwtToken(t, 5*time.Minute)
 	f := createCliConfigFile(t, jwtToken)
 	config.SetCliConfigPath(f.Name())
 	defer func(name string) {
@@ -150,6 +150,23 @@ func TestParseResourceConfigData(t *testing.T) {
 				"client_assertion_private_key": "private-key",
 				"client_assertion_signing_alg": "signing-alg",
 				"custom_domain_header":         "custom-domain",
+				"cache_get_requests":           true,
+				"token_cache_path":             "/tmp/auth0-tokens.json",
+				"read_only":                    true,
+				"adopt_existing":               true,
+				"audit_log_path":               "/tmp/auth0-audit.log",
+				"proxy_url":                    "http://proxy.example.com:3128",
+				"default_metadata": []interface{}{
+					map[string]interface{}{
+						"metadata": map[string]interface{}{"managed_by": "terraform"},
+					},
+				},
+				"debug_redaction": []interface{}{
+					map[string]interface{}{
+						"json_paths": []interface{}{"options.configuration"},
+						"headers":    []interface{}{"X-Correlation-Id"},
+					},
+				},
 			},
 			expectedDiagnostics: nil,
 			expectedConfig: config.ProviderConfig{
@@ -162,6 +179,47 @@ func TestParseResourceConfigData(t *testing.T) {
 				ClientAssertionPrivateKey: "private-key",
 				ClientAssertionSigningAlg: "signing-alg",
 				CustomDomainHeader:        "custom-domain",
+				CacheGetRequests:          true,
+				TokenCachePath:            "/tmp/auth0-tokens.json",
+				ReadOnly:                  true,
+				AdoptExisting:             true,
+				AuditLogPath:              "/tmp/auth0-audit.log",
+				Transport: config.TransportConfig{
+					ProxyURL: "http://proxy.example.com:3128",
+				},
+				DefaultMetadata: config.DefaultMetadata{"managed_by": "terraform"},
+				DebugRedaction: config.DebugRedaction{
+					JSONPaths: []string{"options.configuration"},
+					Headers:   []string{"X-Correlation-Id"},
+				},
+			},
+		},
+		{
+			name: "it parses the retry configuration",
+			givenTerraformConfig: map[string]interface{}{
+				"domain":    "example.auth0.com",
+				"api_token": "api-token",
+				"retry": []interface{}{
+					map[string]interface{}{
+						"max_attempts":        2,
+						"min_backoff":         "100ms",
+						"max_backoff":         "2s",
+						"retry_on_statuses":   []interface{}{503, 429},
+						"max_rate_limit_wait": "5s",
+					},
+				},
+			},
+			expectedDiagnostics: nil,
+			expectedConfig: config.ProviderConfig{
+				Domain:   "example.auth0.com",
+				APIToken: "api-token",
+				Retry: config.RetryPolicy{
+					MaxAttempts:      2,
+					MinBackoff:       100 * time.Millisecond,
+					MaxBackoff:       2 * time.Second,
+					RetryOnStatuses:  []int{429, 503},
+					MaxRateLimitWait: 5 * time.Second,
+				},
 			},
 		},
 		{
@@ -219,6 +277,31 @@ func TestParseResourceConfigData(t *testing.T) {
 			}},
 			expectedConfig: config.ProviderConfig{},
 		},
+		{
+			name: "it returns an error when credential_process is set and domain is empty",
+			givenTerraformConfig: map[string]interface{}{
+				"domain":             "",
+				"credential_process": "vault-helper auth0",
+			},
+			expectedDiagnostics: diag.Diagnostics{{
+				Severity: diag.Error,
+				Summary:  "Missing required configuration",
+				Detail:   "The 'AUTH0_DOMAIN' must be specified along with 'AUTH0_CREDENTIAL_PROCESS'.",
+			}},
+			expectedConfig: config.ProviderConfig{},
+		},
+		{
+			name: "it parses the credential_process",
+			givenTerraformConfig: map[string]interface{}{
+				"domain":             "example.auth0.com",
+				"credential_process": "vault-helper auth0",
+			},
+			expectedDiagnostics: nil,
+			expectedConfig: config.ProviderConfig{
+				Domain:            "example.auth0.com",
+				CredentialProcess: "vault-helper auth0",
+			},
+		},
 		{
 			name: "it returns an error when cli_login is set and domain is empty",
 			givenTerraformConfig: map[string]interface{}{
@@ -282,7 +365,8 @@ func TestParseResourceConfigData(t *testing.T) {
 					Detail: "AUTH0_DOMAIN is required. Then, configure either AUTH0_API_TOKEN, " +
 						"or AUTH0_CLIENT_ID and AUTH0_CLIENT_SECRET, " +
 						"or AUTH0_CLIENT_ID, AUTH0_CLIENT_ASSERTION_PRIVATE_KEY, and AUTH0_CLIENT_ASSERTION_SIGNING_ALG, " +
-						"or enable CLI login with AUTH0_CLI_LOGIN=true.",
+						"or enable CLI login with AUTH0_CLI_LOGIN=true, " +
+						"or configure a credential process with AUTH0_CREDENTIAL_PROCESS.",
 				},
 			},
 			expectedConfig: config.ProviderConfig{},
@@ -305,24 +389,12 @@ func TestParseResourceConfigData(t *testing.T) {
 			}
 
 			assert.Nil(t, diags)
-			assert.Equal(t, testCase.expectedConfig, cfg)
+			// The unexported fields hold the clients and token sources built out of the configuration.
+			assert.EqualExportedValues(t, testCase.expectedConfig, cfg)
 		})
 	}
 }
 
-func createJwtToken(t *testing.T) string {
-	token, err := jwt.NewBuilder().
-		Expiration(time.Now().Add(5 * time.Minute)).
-		Build()
-	assert.NoError(t, err)
-
-	signed, err := jwt.Sign(token, jwt.WithInsecureNoSignature())
-	assert.NoError(t, err)
-
-	jwtToken := string(signed)
-	return jwtToken
-}
-
 func createCliConfigFile(t *testing.T, token string) *os.File {
 	f, err := os.CreateTemp("", "auth0-terraform-provider-test")
 	assert.NoError(t, err)
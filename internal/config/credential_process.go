@@ -0,0 +1,236 @@
+package config
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os/exec"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	// credentialProcessTimeout bounds how long the credential process may run.
+	credentialProcessTimeout = time.Minute
+
+	// credentialProcessExpiryMargin is how long before the expiry of the
+	// access token the credential process gets invoked again.
+	credentialProcessExpiryMargin = 5 * time.Minute
+)
+
+// credentialProcessOutput is the JSON document printed on stdout by the credential process.
+// It holds either an access token or a set of client credentials.
+type credentialProcessOutput struct {
+	AccessToken               string    `json:"access_token,omitempty"`
+	ExpiresAt                 time.Time `json:"expires_at"`
+	ClientID                  string    `json:"client_id,omitempty"`
+	ClientSecret              string    `json:"client_secret,omitempty"`
+	ClientAssertionPrivateKey string    `json:"client_assertion_private_key,omitempty"`
+	ClientAssertionSigningAlg string    `json:"client_assertion_signing_alg,omitempty"`
+	Audience                  string    `json:"audience,omitempty"`
+}
+
+// credentialProcess runs an external command to obtain credentials,
+// and runs it again whenever the access token it returned nears expiry.
+type credentialProcess struct {
+	command []string
+
+	mu     sync.Mutex
+	output *credentialProcessOutput
+}
+
+func newCredentialProcess(commandLine string) (*credentialProcess, error) {
+	command, err := splitCommandLine(commandLine)
+	if err != nil {
+		return nil, fmt.Errorf("invalid credential_process %q: %w", commandLine, err)
+	}
+
+	if len(command) == 0 {
+		return nil, fmt.Errorf("invalid credential_process: the command is empty")
+	}
+
+	return &credentialProcess{command: command}, nil
+}
+
+// credentials returns the current credentials, invoking
+// the process if none are known or the token nears expiry.
+func (p *credentialProcess) credentials(ctx context.Context) (*credentialProcessOutput, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.output != nil && !p.output.nearsExpiry() {
+		return p.output, nil
+	}
+
+	output, err := p.run(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	p.output = output
+
+	return output, nil
+}
+
+// nearsExpiry reports whether the access token must be renewed. Client
+// credentials and tokens without a known expiry never need to be renewed.
+func (o *credentialProcessOutput) nearsExpiry() bool {
+	if o.AccessToken == "" || o.ExpiresAt.IsZero() {
+		return false
+	}
+
+	return time.Until(o.ExpiresAt) < credentialProcessExpiryMargin
+}
+
+func (p *credentialProcess) run(ctx context.Context) (*credentialProcessOutput, error) {
+	ctx, cancel := context.WithTimeout(ctx, credentialProcessTimeout)
+	defer cancel()
+
+	tflog.Debug(ctx, "Running the credential process", map[string]interface{}{
+		"command": p.command[0],
+	})
+
+	var stdout, stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, p.command[0], p.command[1:]...) // #nosec G204 -- The command is configured by the user.
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		return nil, fmt.Errorf("the credential process %q failed: %w: %s",
+			p.command[0], err, strings.TrimSpace(stderr.String()))
+	}
+
+	output := &credentialProcessOutput{}
+	if err := json.Unmarshal(stdout.Bytes(), output); err != nil {
+		return nil, fmt.Errorf("failed to parse the output of the credential process %q: %w", p.command[0], err)
+	}
+
+	switch {
+	case output.AccessToken != "":
+		if output.ExpiresAt.IsZero() {
+			// Fall back to the exp claim when the process didn't tell when the token expires.
+			if expiresAt, err := tokenExpiresAt(output.AccessToken); err == nil {
+				output.ExpiresAt = expiresAt
+			}
+		}
+
+		if !output.ExpiresAt.IsZero() && time.Now().After(output.ExpiresAt) {
+			return nil, fmt.Errorf("the credential process %q returned an expired access token", p.command[0])
+		}
+	case output.ClientID != "":
+		hasAssertion := output.ClientAssertionPrivateKey != "" && output.ClientAssertionSigningAlg != ""
+		if output.ClientSecret == "" && !hasAssertion {
+			return nil, fmt.Errorf("the credential process %q returned a client_id without a client_secret "+
+				"or a client_assertion_private_key and client_assertion_signing_alg", p.command[0])
+		}
+	default:
+		return nil, fmt.Errorf("the credential process %q must return either an access_token or a client_id", p.command[0])
+	}
+
+	return output, nil
+}
+
+// applyTo sets the credentials returned by the process on the provider configuration.
+func (o *credentialProcessOutput) applyTo(cfg *ProviderConfig) {
+	if o.AccessToken != "" {
+		cfg.APIToken = o.AccessToken
+		return
+	}
+
+	cfg.ClientID = o.ClientID
+	cfg.ClientSecret = o.ClientSecret
+	cfg.ClientAssertionPrivateKey = o.ClientAssertionPrivateKey
+	cfg.ClientAssertionSigningAlg = o.ClientAssertionSigningAlg
+	if o.Audience != "" {
+		cfg.Audience = o.Audience
+	}
+}
+
+// credentialProcessTransport wraps an http.RoundTripper to replace the bearer token set by the
+// SDKs, which only know the token returned by the first run of the credential process, with
+// the current one so that long-running operations keep working past the token expiry.
+type credentialProcessTransport struct {
+	transport http.RoundTripper
+	process   *credentialProcess
+}
+
+func (t *credentialProcessTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if !strings.HasPrefix(req.Header.Get("Authorization"), "Bearer ") {
+		return t.transport.RoundTrip(req)
+	}
+
+	credentials, err := t.process.credentials(req.Context())
+	if err != nil {
+		return nil, err
+	}
+
+	if credentials.AccessToken != "" {
+		req = req.Clone(req.Context())
+		req.Header.Set("Authorization", "Bearer "+credentials.AccessToken)
+	}
+
+	return t.transport.RoundTrip(req)
+}
+
+func newCredentialProcessTransport(tripper http.RoundTripper, process *credentialProcess) http.RoundTripper {
+	return &credentialProcessTransport{
+		transport: tripper,
+		process:   process,
+	}
+}
+
+// splitCommandLine splits a command line into its arguments, honouring
+// single quotes, double quotes and backslash escapes, without invoking a shell.
+func splitCommandLine(commandLine string) ([]string, error) {
+	var (
+		args    []string
+		current strings.Builder
+		inArg   bool
+		quote   rune
+		escaped bool
+	)
+
+	for _, r := range commandLine {
+		switch {
+		case escaped:
+			current.WriteRune(r)
+			escaped = false
+		case r == '\\' && quote != '\'':
+			escaped = true
+			inArg = true
+		case quote != 0:
+			if r == quote {
+				quote = 0
+			} else {
+				current.WriteRune(r)
+			}
+		case r == '\'' || r == '"':
+			quote = r
+			inArg = true
+		case r == ' ' || r == '\t' || r == '\n':
+			if inArg {
+				args = append(args, current.String())
+				current.Reset()
+				inArg = false
+			}
+		default:
+			current.WriteRune(r)
+			inArg = true
+		}
+	}
+
+	if escaped || quote != 0 {
+		return nil, fmt.Errorf("unterminated quote or escape")
+	}
+
+	if inArg {
+		args = append(args, current.String())
+	}
+
+	return args, nil
+}
@@ -0,0 +1,175 @@
+package config
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestCredentialProcessHelper is not a real test, it is the
+// credential process run by the tests below through the test binary.
+func TestCredentialProcessHelper(_ *testing.T) {
+	output, ok := os.LookupEnv("AUTH0_TEST_CREDENTIAL_PROCESS_OUTPUT")
+	if !ok {
+		return
+	}
+
+	if counter := os.Getenv("AUTH0_TEST_CREDENTIAL_PROCESS_COUNTER"); counter != "" {
+		calls, _ := os.ReadFile(counter)
+		_ = os.WriteFile(counter, append(calls, '.'), 0o600)
+	}
+
+	if output == "fail" {
+		_, _ = fmt.Fprint(os.Stderr, "vault is sealed")
+		os.Exit(1)
+	}
+
+	_, _ = fmt.Fprint(os.Stdout, output)
+	os.Exit(0)
+}
+
+func helperCredentialProcess(t *testing.T, output string) (*credentialProcess, func() int) {
+	t.Helper()
+
+	counter := filepath.Join(t.TempDir(), "counter")
+	t.Setenv("AUTH0_TEST_CREDENTIAL_PROCESS_OUTPUT", output)
+	t.Setenv("AUTH0_TEST_CREDENTIAL_PROCESS_COUNTER", counter)
+
+	process, err := newCredentialProcess(strconv.Quote(os.Args[0]) + " -test.run=^TestCredentialProcessHelper$")
+	require.NoError(t, err)
+
+	return process, func() int {
+		calls, _ := os.ReadFile(counter)
+		return len(calls)
+	}
+}
+
+func TestCredentialProcess(t *testing.T) {
+	t.Run("it returns the access token and reuses it until it nears expiry", func(t *testing.T) {
+		expiresAt := time.Now().Add(time.Hour).UTC().Format(time.RFC3339)
+		process, calls := helperCredentialProcess(t, `{"access_token":"token","expires_at":"`+expiresAt+`"}`)
+
+		for i := 0; i < 2; i++ {
+			credentials, err := process.credentials(context.Background())
+			require.NoError(t, err)
+			assert.Equal(t, "token", credentials.AccessToken)
+		}
+		assert.Equal(t, 1, calls())
+
+		// Pretend the token is about to expire.
+		process.output.ExpiresAt = time.Now().Add(time.Minute)
+
+		_, err := process.credentials(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, 2, calls())
+	})
+
+	t.Run("it falls back to the exp claim of the access token", func(t *testing.T) {
+		token := createJwtToken(t, time.Hour)
+		process, _ := helperCredentialProcess(t, `{"access_token":"`+token+`"}`)
+
+		credentials, err := process.credentials(context.Background())
+		require.NoError(t, err)
+		assert.WithinDuration(t, time.Now().Add(time.Hour), credentials.ExpiresAt, 5*time.Second)
+	})
+
+	t.Run("it returns client credentials", func(t *testing.T) {
+		process, calls := helperCredentialProcess(t, `{"client_id":"client-id","client_secret":"secret","audience":"aud"}`)
+
+		credentials, err := process.credentials(context.Background())
+		require.NoError(t, err)
+
+		var cfg ProviderConfig
+		credentials.applyTo(&cfg)
+		assert.Equal(t, ProviderConfig{ClientID: "client-id", ClientSecret: "secret", Audience: "aud"}, cfg)
+
+		_, err = process.credentials(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, 1, calls())
+	})
+
+	t.Run("it returns an error on invalid output", func(t *testing.T) {
+		var testCases = []struct {
+			output        string
+			expectedError string
+		}{
+			{output: `not json`, expectedError: "failed to parse the output of the credential process"},
+			{output: `{}`, expectedError: "must return either an access_token or a client_id"},
+			{output: `{"client_id":"client-id"}`, expectedError: "returned a client_id without a client_secret"},
+			{output: `{"access_token":"token","expires_at":"2020-01-01T00:00:00Z"}`, expectedError: "returned an expired access token"},
+			{output: `fail`, expectedError: "vault is sealed"},
+		}
+
+		for _, testCase := range testCases {
+			process, _ := helperCredentialProcess(t, testCase.output)
+
+			_, err := process.credentials(context.Background())
+			assert.ErrorContains(t, err, testCase.expectedError)
+		}
+	})
+}
+
+func TestCredentialProcessTransport(t *testing.T) {
+	var authorizations []string
+	testServer := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		authorizations = append(authorizations, request.Header.Get("Authorization"))
+		writer.WriteHeader(http.StatusOK)
+	}))
+	t.Cleanup(testServer.Close)
+
+	expiresAt := time.Now().Add(time.Hour).UTC().Format(time.RFC3339)
+	process, _ := helperCredentialProcess(t, `{"access_token":"fresh-token","expires_at":"`+expiresAt+`"}`)
+
+	client := &http.Client{Transport: newCredentialProcessTransport(http.DefaultTransport, process)}
+
+	for _, authorization := range []string{"Bearer stale-token", ""} {
+		request, err := http.NewRequest(http.MethodGet, testServer.URL, nil)
+		require.NoError(t, err)
+		if authorization != "" {
+			request.Header.Set("Authorization", authorization)
+		}
+
+		response, err := client.Do(request)
+		require.NoError(t, err)
+		require.NoError(t, response.Body.Close())
+	}
+
+	assert.Equal(t, []string{"Bearer fresh-token", ""}, authorizations)
+}
+
+func TestSplitCommandLine(t *testing.T) {
+	var testCases = []struct {
+		commandLine string
+		expected    []string
+	}{
+		{commandLine: "helper", expected: []string{"helper"}},
+		{commandLine: "  helper  get   auth0 ", expected: []string{"helper", "get", "auth0"}},
+		{commandLine: `helper --path "my tenant/prod"`, expected: []string{"helper", "--path", "my tenant/prod"}},
+		{commandLine: `helper 'it''s' "a \"b\""`, expected: []string{"helper", "its", `a "b"`}},
+		{commandLine: `C:\\tools\\helper.exe ""`, expected: []string{`C:\tools\helper.exe`, ""}},
+		{commandLine: "", expected: nil},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.commandLine, func(t *testing.T) {
+			actual, err := splitCommandLine(testCase.commandLine)
+			require.NoError(t, err)
+			assert.Equal(t, testCase.expected, actual)
+		})
+	}
+
+	_, err := splitCommandLine(`helper "unterminated`)
+	assert.Error(t, err)
+
+	_, err = newCredentialProcess("  ")
+	assert.ErrorContains(t, err, "the command is empty")
+}
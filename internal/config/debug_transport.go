@@ -0,0 +1,95 @@
+package config
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// DebugRedaction holds the rules redacting secrets from the debug
+// HTTP logs on top of the built-in ones.
+type DebugRedaction struct {
+	// JSONPaths lists dot-separated paths to JSON fields, e.g. `options.client_secret`.
+	// Arrays are traversed implicitly and `*` matches any field name.
+	JSONPaths []string
+	// Headers lists the names of the request and response headers to redact.
+	Headers []string
+}
+
+// debugTransport wraps an http.RoundTripper to log every request
+// and response through tflog, with secrets redacted.
+type debugTransport struct {
+	transport http.RoundTripper
+	rules     *redactionRules
+}
+
+func (t *debugTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	req, requestBody, err := bufferRequestBody(req)
+	if err != nil {
+		return nil, err
+	}
+
+	fields := map[string]interface{}{
+		"method":          req.Method,
+		"url":             req.URL.String(),
+		"request_headers": t.rules.redactHeaders(req.Header),
+		"request_body":    t.rules.redactBody(requestBody, req.Header.Get("Content-Type")),
+	}
+
+	start := time.Now()
+	resp, err := t.transport.RoundTrip(req)
+	fields["duration"] = time.Since(start).String()
+
+	if err != nil {
+		fields["error"] = err.Error()
+		tflog.Debug(req.Context(), "Auth0 API request failed", fields)
+		return nil, err
+	}
+
+	responseBody, err := io.ReadAll(resp.Body)
+	_ = resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(responseBody))
+
+	fields["status"] = resp.StatusCode
+	fields["response_headers"] = t.rules.redactHeaders(resp.Header)
+	fields["response_body"] = t.rules.redactBody(responseBody, resp.Header.Get("Content-Type"))
+
+	tflog.Debug(req.Context(), "Auth0 API request", fields)
+
+	return resp, nil
+}
+
+func newDebugTransport(tripper http.RoundTripper, redaction DebugRedaction) http.RoundTripper {
+	return &debugTransport{
+		transport: tripper,
+		rules:     newRedactionRules(redaction),
+	}
+}
+
+// bufferRequestBody reads the body of the request, returning a copy
+// of the request whose body can be read again along with the body.
+func bufferRequestBody(req *http.Request) (*http.Request, []byte, error) {
+	if req.Body == nil || req.Body == http.NoBody {
+		return req, nil, nil
+	}
+
+	body, err := io.ReadAll(req.Body)
+	_ = req.Body.Close()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req = req.Clone(req.Context())
+	req.Body = io.NopCloser(bytes.NewReader(body))
+	req.GetBody = func() (io.ReadCloser, error) {
+		return io.NopCloser(bytes.NewReader(body)), nil
+	}
+
+	return req, body, nil
+}
@@ -0,0 +1,78 @@
+package config
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-log/tflogtest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDebugTransport(t *testing.T) {
+	testServer := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		body, _ := io.ReadAll(request.Body)
+
+		writer.Header().Set("Content-Type", "application/json; charset=utf-8")
+		writer.Header().Set("Set-Cookie", "did=secret-cookie")
+		writer.Header().Set("X-Ratelimit-Remaining", "10")
+		writer.WriteHeader(http.StatusOK)
+		_, _ = writer.Write(body)
+	}))
+	t.Cleanup(testServer.Close)
+
+	var output bytes.Buffer
+	ctx := tflogtest.RootLogger(context.Background(), &output)
+
+	client := &http.Client{
+		Transport: newDebugTransport(http.DefaultTransport, DebugRedaction{
+			JSONPaths: []string{"options.custom_scripts.*"},
+			Headers:   []string{"x-ratelimit-remaining"},
+		}),
+	}
+
+	body := `{"name":"conn","client_secret":"s1","options":{"client_secret":"s2","custom_scripts":{"login":"code"},` +
+		`"upstream_params":{"a":"b"}},"secrets":[{"name":"API_KEY","value":"s3"},{"name":"OTHER","value":"s4"}]}`
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, testServer.URL+"/api/v2/connections", strings.NewReader(body))
+	require.NoError(t, err)
+	request.Header.Set("Authorization", "Bearer secret-token")
+	request.Header.Set("Content-Type", "application/json")
+
+	response, err := client.Do(request)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = response.Body.Close() })
+
+	// The response body is still readable after being logged.
+	responseBody, err := io.ReadAll(response.Body)
+	require.NoError(t, err)
+	assert.Equal(t, body, string(responseBody))
+
+	entries, err := tflogtest.MultilineJSONDecode(&output)
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+
+	entry := entries[0]
+	assert.Equal(t, "Auth0 API request", entry["@message"])
+	assert.Equal(t, "POST", entry["method"])
+	assert.Equal(t, float64(http.StatusOK), entry["status"])
+
+	expectedBody := `{"name":"conn","client_secret":"[REDACTED]","options":{"client_secret":"[REDACTED]",` +
+		`"custom_scripts":{"login":"[REDACTED]"},"upstream_params":{"a":"b"}},` +
+		`"secrets":[{"name":"API_KEY","value":"[REDACTED]"},{"name":"OTHER","value":"[REDACTED]"}]}`
+	assert.JSONEq(t, expectedBody, entry["request_body"].(string))
+	assert.JSONEq(t, expectedBody, entry["response_body"].(string))
+
+	requestHeaders := entry["request_headers"].(map[string]interface{})
+	assert.Equal(t, redactedValue, requestHeaders["Authorization"])
+	assert.Equal(t, "application/json", requestHeaders["Content-Type"])
+
+	responseHeaders := entry["response_headers"].(map[string]interface{})
+	assert.Equal(t, redactedValue, responseHeaders["Set-Cookie"])
+	assert.Equal(t, redactedValue, responseHeaders["X-Ratelimit-Remaining"])
+	assert.NotContains(t, output.String(), "secret-")
+}
@@ -0,0 +1,112 @@
+package config
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// DefaultMetadata holds the metadata the provider adds to the metadata
+// of the clients, organizations and connections it manages.
+type DefaultMetadata map[string]string
+
+// Merge returns the metadata with the default metadata added to it.
+// Keys set in the metadata take precedence over the default ones.
+func (d DefaultMetadata) Merge(metadata *map[string]string) *map[string]string {
+	if len(d) == 0 {
+		return metadata
+	}
+
+	merged := make(map[string]string, len(d))
+	for key, value := range d {
+		merged[key] = value
+	}
+
+	if metadata != nil {
+		for key, value := range *metadata {
+			merged[key] = value
+		}
+	}
+
+	return &merged
+}
+
+// PlanAllMetadata returns a CustomizeDiffFunc planning the computed attribute holding all the
+// metadata of a resource, e.g. `metadata_all`, as the metadata of the resource merged with the
+// default metadata, the way `tags_all` works in other providers.
+//
+// As the attribute holds the metadata read from the API, the plan shows an update whenever
+// a default key is missing from or differs in the API, including on existing resources
+// after the default metadata of the provider changed, and the update sends the metadata.
+func PlanAllMetadata(metadataKey, allMetadataKey string) schema.CustomizeDiffFunc {
+	return func(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+		if !diff.NewValueKnown(metadataKey) {
+			return diff.SetNewComputed(allMetadataKey)
+		}
+
+		metadata := make(map[string]string)
+		for key, value := range diff.Get(metadataKey).(map[string]interface{}) {
+			metadata[key] = value.(string)
+		}
+
+		allMetadata := make(map[string]interface{})
+		if merged := meta.(*Config).GetDefaultMetadata().Merge(&metadata); merged != nil {
+			for key, value := range *merged {
+				allMetadata[key] = value
+			}
+		}
+
+		if metadataEqual(allMetadata, diff.Get(allMetadataKey).(map[string]interface{})) {
+			return nil
+		}
+
+		return diff.SetNew(allMetadataKey, allMetadata)
+	}
+}
+
+func metadataEqual(metadata, otherMetadata map[string]interface{}) bool {
+	if len(metadata) != len(otherMetadata) {
+		return false
+	}
+
+	for key, value := range metadata {
+		otherValue, ok := otherMetadata[key]
+		if !ok || fmt.Sprint(value) != fmt.Sprint(otherValue) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// SuppressDefaultMetadata returns the metadata read from the API without the default metadata,
+// so that it doesn't show up in diffs, the way `default_tags` work in other providers. The default
+// metadata shows up in the attribute holding all the metadata instead, see PlanAllMetadata.
+//
+// A default key is kept when the resource sets it itself, which is told by its presence in the
+// metadata known to Terraform, or when its value no longer matches the default, so that the
+// drift shows up in the plan and gets corrected by the next apply.
+func SuppressDefaultMetadata[V any](
+	defaultMetadata DefaultMetadata,
+	metadata map[string]V,
+	knownMetadata map[string]interface{},
+) map[string]V {
+	if len(defaultMetadata) == 0 || metadata == nil {
+		return metadata
+	}
+
+	suppressed := make(map[string]V, len(metadata))
+	for key, value := range metadata {
+		defaultValue, isDefault := defaultMetadata[key]
+		_, isKnown := knownMetadata[key]
+
+		if isDefault && !isKnown && fmt.Sprint(value) == defaultValue {
+			continue
+		}
+
+		suppressed[key] = value
+	}
+
+	return suppressed
+}
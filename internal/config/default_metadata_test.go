@@ -0,0 +1,141 @@
+package config
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDefaultMetadata_Merge(t *testing.T) {
+	defaultMetadata := DefaultMetadata{"managed_by": "terraform", "team": "identity"}
+
+	assert.Equal(t,
+		&map[string]string{"managed_by": "terraform", "team": "platform", "tier": "gold"},
+		defaultMetadata.Merge(&map[string]string{"team": "platform", "tier": "gold"}),
+	)
+	assert.Equal(t, &map[string]string{"managed_by": "terraform", "team": "identity"}, defaultMetadata.Merge(nil))
+
+	metadata := &map[string]string{"tier": "gold"}
+	assert.Same(t, metadata, DefaultMetadata(nil).Merge(metadata))
+	assert.Nil(t, DefaultMetadata(nil).Merge(nil))
+}
+
+func TestSuppressDefaultMetadata(t *testing.T) {
+	defaultMetadata := DefaultMetadata{"managed_by": "terraform", "team": "identity", "env": "prod"}
+
+	var testCases = []struct {
+		name          string
+		metadata      map[string]string
+		knownMetadata map[string]interface{}
+		expected      map[string]string
+	}{
+		{
+			name:     "it removes the keys holding their default value",
+			metadata: map[string]string{"managed_by": "terraform", "team": "identity", "tier": "gold"},
+			expected: map[string]string{"tier": "gold"},
+		},
+		{
+			name:          "it keeps the keys set on the resource",
+			metadata:      map[string]string{"managed_by": "terraform", "team": "identity"},
+			knownMetadata: map[string]interface{}{"team": "identity"},
+			expected:      map[string]string{"team": "identity"},
+		},
+		{
+			name:     "it keeps the keys that drifted from their default value",
+			metadata: map[string]string{"managed_by": "someone", "team": "identity"},
+			expected: map[string]string{"managed_by": "someone"},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			actual := SuppressDefaultMetadata(defaultMetadata, testCase.metadata, testCase.knownMetadata)
+			assert.Equal(t, testCase.expected, actual)
+		})
+	}
+
+	clientMetadata := map[string]interface{}{"managed_by": "terraform", "tier": "gold"}
+	assert.Equal(t, map[string]interface{}{"tier": "gold"}, SuppressDefaultMetadata(defaultMetadata, clientMetadata, nil))
+	assert.Equal(t, clientMetadata, SuppressDefaultMetadata(nil, clientMetadata, nil))
+}
+
+func TestPlanAllMetadata(t *testing.T) {
+	resource := &schema.Resource{
+		CustomizeDiff: PlanAllMetadata("metadata", "metadata_all"),
+		Schema: map[string]*schema.Schema{
+			"metadata": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"metadata_all": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+	meta := &Config{defaultMetadata: DefaultMetadata{"managed_by": "terraform"}}
+
+	var testCases = []struct {
+		name        string
+		metadata    map[string]interface{}
+		allMetadata map[string]interface{}
+		expected    map[string]interface{}
+		updated     bool
+	}{
+		{
+			name:        "it plans the default metadata missing from the API",
+			metadata:    map[string]interface{}{"tier": "gold"},
+			allMetadata: map[string]interface{}{"tier": "gold"},
+			expected:    map[string]interface{}{"managed_by": "terraform", "tier": "gold"},
+			updated:     true,
+		},
+		{
+			name:        "it plans the default metadata that drifted in the API",
+			allMetadata: map[string]interface{}{"managed_by": "someone"},
+			expected:    map[string]interface{}{"managed_by": "terraform"},
+			updated:     true,
+		},
+		{
+			name:        "it plans the removal of the metadata missing from the configuration",
+			allMetadata: map[string]interface{}{"managed_by": "terraform", "tier": "gold"},
+			expected:    map[string]interface{}{"managed_by": "terraform"},
+			updated:     true,
+		},
+		{
+			name:        "it plans nothing when the API holds the metadata and the default metadata",
+			metadata:    map[string]interface{}{"tier": "gold"},
+			allMetadata: map[string]interface{}{"managed_by": "terraform", "tier": "gold"},
+			expected:    map[string]interface{}{"managed_by": "terraform", "tier": "gold"},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			state := schema.TestResourceDataRaw(t, resource.Schema, map[string]interface{}{
+				"metadata":     testCase.metadata,
+				"metadata_all": testCase.allMetadata,
+			})
+			state.SetId("1")
+
+			configuration := map[string]interface{}{}
+			if testCase.metadata != nil {
+				configuration["metadata"] = testCase.metadata
+			}
+
+			diff, err := resource.Diff(context.Background(), state.State(), terraform.NewResourceConfigRaw(configuration), meta)
+			require.NoError(t, err)
+
+			planned, err := schema.InternalMap(resource.Schema).Data(state.State(), diff)
+			require.NoError(t, err)
+
+			assert.Equal(t, testCase.expected, planned.Get("metadata_all"))
+			assert.Equal(t, testCase.updated, planned.HasChange("metadata_all"))
+		})
+	}
+}
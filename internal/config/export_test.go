@@ -0,0 +1,4 @@
+package config
+
+// CreateJwtToken lets the tests of the config_test package build tokens like the other tests do.
+var CreateJwtToken = createJwtToken
@@ -0,0 +1,199 @@
+package config
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// rateLimitPacerReserve is the fraction of the rate limit bucket below
+// which the pacer starts spacing out outgoing requests. It matches the
+// threshold at which rateLimitLoggingTransport starts reporting consumption.
+const rateLimitPacerReserve = 0.25
+
+// rateLimitPacer is a client-side token bucket that mirrors the Management API
+// rate limit bucket reported through the X-RateLimit-* response headers.
+//
+// Every response refreshes the bucket size, the tokens left and the refill rate,
+// and every request takes a token out of the local bucket. Once the bucket drops
+// below the reserve, requests are delayed just long enough for the bucket to
+// refill, which spreads requests over time instead of bursting into a 429.
+type rateLimitPacer struct {
+	mu         sync.Mutex
+	limit      float64
+	tokens     float64
+	refillRate float64 // Tokens per second.
+	updatedAt  time.Time
+	now        func() time.Time
+}
+
+func newRateLimitPacer() *rateLimitPacer {
+	return &rateLimitPacer{
+		now: time.Now,
+	}
+}
+
+// rateLimitPacers holds the pacers of a provider configuration, one per
+// domain, as each tenant has its own rate limit bucket. It is shared by
+// the v1 and v3 clients of the configuration, but not with its aliases.
+type rateLimitPacers struct {
+	mu      sync.Mutex
+	domains map[string]*rateLimitPacer
+}
+
+func newRateLimitPacers() *rateLimitPacers {
+	return &rateLimitPacers{
+		domains: map[string]*rateLimitPacer{},
+	}
+}
+
+// forDomain returns the pacer of the domain, creating it on first use.
+func (p *rateLimitPacers) forDomain(domain string) *rateLimitPacer {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	pacer, ok := p.domains[domain]
+	if !ok {
+		pacer = newRateLimitPacer()
+		p.domains[domain] = pacer
+	}
+
+	return pacer
+}
+
+// refill adds the tokens regenerated since the last update. Callers must hold the lock.
+func (p *rateLimitPacer) refill(now time.Time) {
+	if elapsed := now.Sub(p.updatedAt).Seconds(); elapsed > 0 {
+		p.tokens += elapsed * p.refillRate
+		if p.tokens > p.limit {
+			p.tokens = p.limit
+		}
+	}
+
+	p.updatedAt = now
+}
+
+// reserve takes a token out of the bucket and returns
+// how long the caller must wait before sending its request.
+func (p *rateLimitPacer) reserve() time.Duration {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	// Nothing is known about the rate limit yet, so there is nothing to pace.
+	if p.limit <= 0 {
+		return 0
+	}
+
+	p.refill(p.now())
+	p.tokens--
+
+	floor := p.limit * rateLimitPacerReserve
+	if p.tokens >= floor || p.refillRate <= 0 {
+		return 0
+	}
+
+	return time.Duration((floor - p.tokens) / p.refillRate * float64(time.Second))
+}
+
+// observe updates the bucket from the rate limit headers of a response.
+func (p *rateLimitPacer) observe(header http.Header) {
+	limit, err := strconv.Atoi(header.Get("X-RateLimit-Limit"))
+	if err != nil || limit <= 0 {
+		return
+	}
+
+	remaining, err := strconv.Atoi(header.Get("X-RateLimit-Remaining"))
+	if err != nil || remaining < 0 {
+		return
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	now := p.now()
+	p.limit = float64(limit)
+	p.tokens = float64(remaining)
+	p.updatedAt = now
+
+	// The reset header is the time at which the bucket will be full again,
+	// so the refill rate is the number of missing tokens over that window.
+	resetAtUnix, err := strconv.ParseInt(header.Get("X-RateLimit-Reset"), 10, 64)
+	if err != nil {
+		return
+	}
+
+	untilReset := time.Unix(resetAtUnix, 0).Sub(now).Seconds()
+	if untilReset > 0 && remaining < limit {
+		p.refillRate = float64(limit-remaining) / untilReset
+	}
+}
+
+// rateLimitPacingTransport wraps an http.RoundTripper to delay requests
+// when the rate limit bucket of their domain is about to run out.
+type rateLimitPacingTransport struct {
+	transport http.RoundTripper
+	pacers    *rateLimitPacers
+	metrics   *rateLimitMetrics
+}
+
+func (t *rateLimitPacingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+	pacer := t.pacers.forDomain(req.URL.Host)
+
+	if delay := pacer.reserve(); delay > 0 {
+		tflog.Debug(ctx, "Pacing request to stay within the Auth0 rate limit",
+			map[string]interface{}{
+				"method":        req.Method,
+				"path":          req.URL.Path,
+				"wait_duration": delay.String(),
+			},
+		)
+
+		start := time.Now()
+		err := sleepWithContext(ctx, delay)
+		if t.metrics != nil {
+			t.metrics.recordPacingWait(req.Method, endpointTemplate(req.URL.Path), time.Since(start))
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := t.transport.RoundTrip(req)
+	if err != nil {
+		return resp, err
+	}
+
+	pacer.observe(resp.Header)
+
+	return resp, err
+}
+
+func newRateLimitPacingTransport(
+	tripper http.RoundTripper,
+	pacers *rateLimitPacers,
+	metrics *rateLimitMetrics,
+) http.RoundTripper {
+	return &rateLimitPacingTransport{
+		transport: tripper,
+		pacers:    pacers,
+		metrics:   metrics,
+	}
+}
+
+// sleepWithContext pauses for the given duration or until the context is done.
+func sleepWithContext(ctx context.Context, duration time.Duration) error {
+	timer := time.NewTimer(duration)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
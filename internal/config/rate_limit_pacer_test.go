@@ -0,0 +1,163 @@
+package config
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func rateLimitHeaders(limit, remaining int, resetAt time.Time) http.Header {
+	header := http.Header{}
+	header.Set("X-RateLimit-Limit", strconv.Itoa(limit))
+	header.Set("X-RateLimit-Remaining", strconv.Itoa(remaining))
+	header.Set("X-RateLimit-Reset", strconv.FormatInt(resetAt.Unix(), 10))
+	return header
+}
+
+func TestRateLimitPacer_DoesNotPaceWithoutRateLimitInfo(t *testing.T) {
+	pacer := newRateLimitPacer()
+
+	for i := 0; i < 100; i++ {
+		assert.Equal(t, time.Duration(0), pacer.reserve())
+	}
+}
+
+func TestRateLimitPacer_DoesNotPaceAboveReserve(t *testing.T) {
+	now := time.Unix(1_700_000_000, 0)
+	pacer := newRateLimitPacer()
+	pacer.now = func() time.Time { return now }
+
+	pacer.observe(rateLimitHeaders(100, 90, now.Add(10*time.Second)))
+
+	// 90 tokens left and a floor of 25, so 65 requests can go out straight away.
+	for i := 0; i < 65; i++ {
+		assert.Equal(t, time.Duration(0), pacer.reserve())
+	}
+	assert.Greater(t, pacer.reserve(), time.Duration(0))
+}
+
+func TestRateLimitPacer_PacesBelowReserve(t *testing.T) {
+	now := time.Unix(1_700_000_000, 0)
+	pacer := newRateLimitPacer()
+	pacer.now = func() time.Time { return now }
+
+	// 80 missing tokens over 8 seconds means a refill rate of 10 tokens per second.
+	pacer.observe(rateLimitHeaders(100, 20, now.Add(8*time.Second)))
+
+	// The floor is 25 tokens, so after taking one the bucket is 6 tokens short.
+	assert.InDelta(t, 600*time.Millisecond, pacer.reserve(), float64(time.Millisecond))
+
+	// Queued requests wait progressively longer.
+	assert.InDelta(t, 700*time.Millisecond, pacer.reserve(), float64(time.Millisecond))
+
+	// Time passing refills the bucket.
+	now = now.Add(time.Second)
+	assert.Equal(t, time.Duration(0), pacer.reserve())
+}
+
+func TestRateLimitPacer_IgnoresInvalidHeaders(t *testing.T) {
+	pacer := newRateLimitPacer()
+
+	header := http.Header{}
+	header.Set("X-RateLimit-Limit", "not-a-number")
+	header.Set("X-RateLimit-Remaining", "0")
+	pacer.observe(header)
+
+	assert.Equal(t, float64(0), pacer.limit)
+	assert.Equal(t, time.Duration(0), pacer.reserve())
+}
+
+func TestRateLimitPacingTransport_DelaysRequestsNearTheLimit(t *testing.T) {
+	var apiCalls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		apiCalls.Add(1)
+		w.Header().Set("X-RateLimit-Limit", "10")
+		w.Header().Set("X-RateLimit-Remaining", "0")
+		w.Header().Set("X-RateLimit-Reset", strconv.Itoa(int(time.Now().Add(2*time.Second).Unix())))
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	metrics := &rateLimitMetrics{minRemaining: -1}
+	client := &http.Client{Transport: newRateLimitPacingTransport(http.DefaultTransport, newRateLimitPacers(), metrics)}
+
+	start := time.Now()
+	for i := 0; i < 2; i++ {
+		req, err := http.NewRequestWithContext(context.Background(), http.MethodGet, server.URL+"/api/v2/clients", nil)
+		require.NoError(t, err)
+
+		resp, err := client.Do(req)
+		require.NoError(t, err)
+		require.NoError(t, resp.Body.Close())
+	}
+
+	assert.Equal(t, int32(2), apiCalls.Load())
+	assert.Greater(t, time.Since(start), 100*time.Millisecond)
+
+	usage := metrics.endpoints["GET /api/v2/clients"]
+	require.NotNil(t, usage)
+	assert.Greater(t, usage.pacingDuration, 100*time.Millisecond)
+	assert.Equal(t, usage.pacingDuration, metrics.totalPacingDuration)
+}
+
+func TestRateLimitPacingTransport_HonoursContextCancellation(t *testing.T) {
+	var apiCalls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		apiCalls.Add(1)
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	serverURL, err := url.Parse(server.URL)
+	require.NoError(t, err)
+
+	now := time.Now()
+	pacers := newRateLimitPacers()
+	pacers.forDomain(serverURL.Host).observe(rateLimitHeaders(10, 0, now.Add(time.Hour)))
+
+	client := &http.Client{Transport: newRateLimitPacingTransport(http.DefaultTransport, pacers, nil)}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, server.URL, nil)
+	require.NoError(t, err)
+
+	_, err = client.Do(req)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Equal(t, int32(0), apiCalls.Load())
+}
+
+func TestRateLimitPacingTransport_PacesEachDomainSeparately(t *testing.T) {
+	var apiCalls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		apiCalls.Add(1)
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	now := time.Now()
+	pacers := newRateLimitPacers()
+	pacers.forDomain("exhausted.auth0.com").observe(rateLimitHeaders(10, 0, now.Add(time.Hour)))
+
+	client := &http.Client{Transport: newRateLimitPacingTransport(http.DefaultTransport, pacers, nil)}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, server.URL, nil)
+	require.NoError(t, err)
+
+	resp, err := client.Do(req)
+	require.NoError(t, err)
+	require.NoError(t, resp.Body.Close())
+	assert.Equal(t, int32(1), apiCalls.Load())
+}
@@ -0,0 +1,51 @@
+package config
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// ReadOnlyError is returned for requests that would modify
+// the tenant while the provider is in read-only mode.
+type ReadOnlyError struct {
+	Method string
+	Path   string
+}
+
+// Error returns a string representation of the error.
+func (e *ReadOnlyError) Error() string {
+	return fmt.Sprintf(
+		"the provider is configured with read_only enabled, refusing to send %s %s to the Auth0 Management API. "+
+			"Disable read_only (or unset AUTH0_READ_ONLY) to allow changes to the tenant",
+		e.Method,
+		e.Path,
+	)
+}
+
+// readOnlyTransport wraps an http.RoundTripper to reject
+// any Management API request other than a GET.
+type readOnlyTransport struct {
+	transport http.RoundTripper
+}
+
+func (t *readOnlyTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Method != http.MethodGet && strings.HasPrefix(req.URL.Path, managementAPIBasePath) {
+		if req.Body != nil {
+			_ = req.Body.Close()
+		}
+
+		return nil, &ReadOnlyError{
+			Method: req.Method,
+			Path:   req.URL.Path,
+		}
+	}
+
+	return t.transport.RoundTrip(req)
+}
+
+func newReadOnlyTransport(tripper http.RoundTripper) http.RoundTripper {
+	return &readOnlyTransport{
+		transport: tripper,
+	}
+}
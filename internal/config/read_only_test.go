@@ -0,0 +1,52 @@
+package config
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCustomClientWithRetries_ReadOnly(t *testing.T) {
+	apiCalls := 0
+	testServer := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, _ *http.Request) {
+		apiCalls++
+		writer.WriteHeader(http.StatusOK)
+	}))
+	t.Cleanup(testServer.Close)
+
+	client := customClientWithRetries(ProviderConfig{ReadOnly: true})
+
+	t.Run("it allows GET requests", func(t *testing.T) {
+		status, _ := doRequest(t, client, http.MethodGet, testServer.URL+"/api/v2/clients")
+		assert.Equal(t, http.StatusOK, status)
+	})
+
+	t.Run("it allows requests outside the Management API", func(t *testing.T) {
+		status, _ := doRequest(t, client, http.MethodPost, testServer.URL+"/oauth/token")
+		assert.Equal(t, http.StatusOK, status)
+	})
+
+	for _, method := range []string{http.MethodPost, http.MethodPatch, http.MethodPut, http.MethodDelete} {
+		t.Run("it rejects "+method+" requests", func(t *testing.T) {
+			callsBefore := apiCalls
+
+			request, err := http.NewRequest(method, testServer.URL+"/api/v2/clients/abc", strings.NewReader(`{}`))
+			require.NoError(t, err)
+
+			_, err = client.Do(request)
+			require.Error(t, err)
+
+			var readOnlyErr *ReadOnlyError
+			require.True(t, errors.As(err, &readOnlyErr))
+			assert.Equal(t, method, readOnlyErr.Method)
+			assert.Equal(t, "/api/v2/clients/abc", readOnlyErr.Path)
+			assert.Contains(t, err.Error(), "refusing to send "+method+" /api/v2/clients/abc")
+			assert.Equal(t, callsBefore, apiCalls)
+		})
+	}
+}
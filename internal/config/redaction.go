@@ -0,0 +1,165 @@
+package config
+
+import (
+	"bytes"
+	"encoding/json"
+	"mime"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// redactedValue replaces the value of the sensitive fields in the logs.
+const redactedValue = "[REDACTED]"
+
+// sensitiveJSONPaths lists the JSON fields of the Management API and Authentication API bodies
+// holding secrets, which are always redacted from the debug HTTP logs and the audit log.
+var sensitiveJSONPaths = []string{
+	// Clients, connections and token requests. The client secret and signing
+	// keys are also redacted from the recorded clients by the acctest recorder.
+	"client_secret",
+	"client_assertion",
+	"signing_keys",
+	"options.client_secret",
+	"options.configuration",
+	"options.password",
+	"password",
+	"secret",
+	"access_token",
+	"refresh_token",
+	"id_token",
+	// Actions.
+	"secrets.value",
+	// Log streams.
+	"sink.httpAuthorization",
+	"sink.datadogApiKey",
+	"sink.splunkToken",
+	"sink.segmentWriteKey",
+	"sink.mixpanelServiceAccountPassword",
+	// SCIM tokens.
+	"token",
+	// Email providers, whose credentials only hold secrets.
+	"credentials",
+	// Guardian factor providers.
+	"auth_token",
+	"aws_secret_access_key",
+	"server_key",
+	"server_credentials",
+}
+
+// sensitiveHeaders lists the headers always redacted from the debug HTTP logs.
+var sensitiveHeaders = []string{
+	"Authorization",
+	"Cookie",
+	"Set-Cookie",
+}
+
+// redactionRules are the compiled rules redacting secrets from the logs.
+type redactionRules struct {
+	jsonPaths [][]string
+	headers   map[string]bool
+}
+
+// defaultRedactionRules are the built-in rules, shared by the debug HTTP logs and the audit log.
+var defaultRedactionRules = newRedactionRules(DebugRedaction{})
+
+// newRedactionRules compiles the built-in rules along with the extra ones.
+func newRedactionRules(redaction DebugRedaction) *redactionRules {
+	rules := &redactionRules{
+		headers: make(map[string]bool),
+	}
+
+	for _, path := range append(append([]string{}, sensitiveJSONPaths...), redaction.JSONPaths...) {
+		rules.jsonPaths = append(rules.jsonPaths, strings.Split(path, "."))
+	}
+
+	for _, header := range append(append([]string{}, sensitiveHeaders...), redaction.Headers...) {
+		rules.headers[http.CanonicalHeaderKey(header)] = true
+	}
+
+	return rules
+}
+
+// redactHeaders returns the headers flattened into a map, with the redacted headers' values replaced.
+func (r *redactionRules) redactHeaders(header http.Header) map[string]string {
+	redacted := make(map[string]string, len(header))
+	for name, values := range header {
+		if r.headers[http.CanonicalHeaderKey(name)] {
+			redacted[name] = redactedValue
+			continue
+		}
+		redacted[name] = strings.Join(values, ", ")
+	}
+
+	return redacted
+}
+
+// redactBody returns the body with the redacted fields' values replaced. JSON and form bodies are
+// supported, any other body is returned as is since the Auth0 APIs only carry secrets in those.
+func (r *redactionRules) redactBody(body []byte, contentType string) string {
+	if len(body) == 0 {
+		return ""
+	}
+
+	mediaType, _, _ := mime.ParseMediaType(contentType)
+	if mediaType == "application/x-www-form-urlencoded" {
+		form, err := url.ParseQuery(string(body))
+		if err != nil {
+			return redactedValue
+		}
+
+		for _, path := range r.jsonPaths {
+			if len(path) == 1 && form.Has(path[0]) {
+				form.Set(path[0], redactedValue)
+			}
+		}
+
+		return form.Encode()
+	}
+
+	redacted, err := r.redactJSON(body)
+	if err != nil {
+		return string(body)
+	}
+
+	return string(redacted)
+}
+
+// redactJSON returns the JSON body with the redacted fields' values replaced.
+func (r *redactionRules) redactJSON(body []byte) ([]byte, error) {
+	var value interface{}
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.UseNumber()
+	if err := decoder.Decode(&value); err != nil {
+		return nil, err
+	}
+
+	for _, path := range r.jsonPaths {
+		redactJSONPath(value, path)
+	}
+
+	return json.Marshal(value)
+}
+
+// redactJSONPath replaces the value of the fields found at the path in the decoded JSON value.
+func redactJSONPath(value interface{}, path []string) {
+	switch value := value.(type) {
+	case []interface{}:
+		for _, item := range value {
+			redactJSONPath(item, path)
+		}
+	case map[string]interface{}:
+		for key, field := range value {
+			if path[0] != "*" && path[0] != key {
+				continue
+			}
+
+			if len(path) == 1 {
+				value[key] = redactedValue
+				continue
+			}
+
+			redactJSONPath(field, path[1:])
+		}
+	}
+}
@@ -0,0 +1,53 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRedactionRules_RedactBody(t *testing.T) {
+	rules := newRedactionRules(DebugRedaction{})
+
+	var testCases = []struct {
+		name        string
+		body        string
+		contentType string
+		expected    string
+	}{
+		{
+			name:        "it redacts form bodies",
+			body:        "grant_type=client_credentials&client_id=id&client_secret=secret",
+			contentType: "application/x-www-form-urlencoded",
+			expected:    "client_id=id&client_secret=%5BREDACTED%5D&grant_type=client_credentials",
+		},
+		{
+			name:        "it redacts log stream sinks",
+			body:        `{"type":"http","sink":{"httpEndpoint":"https://example.com","httpAuthorization":"secret"}}`,
+			contentType: "application/json",
+			expected:    `{"sink":{"httpAuthorization":"[REDACTED]","httpEndpoint":"https://example.com"},"type":"http"}`,
+		},
+		{
+			name:        "it redacts SCIM tokens",
+			body:        `{"token_id":"tok_1","token":"secret","scopes":["get:users"]}`,
+			contentType: "application/json",
+			expected:    `{"scopes":["get:users"],"token":"[REDACTED]","token_id":"tok_1"}`,
+		},
+		{
+			name:        "it leaves other bodies as is",
+			body:        "<html>Bad Gateway</html>",
+			contentType: "text/html",
+			expected:    "<html>Bad Gateway</html>",
+		},
+		{
+			name:     "it returns empty bodies as is",
+			expected: "",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			assert.Equal(t, testCase.expected, rules.redactBody([]byte(testCase.body), testCase.contentType))
+		})
+	}
+}
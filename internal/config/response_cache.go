@@ -0,0 +1,215 @@
+package config
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/auth0/terraform-provider-auth0/internal/wait"
+)
+
+// managementAPIBasePath is the path prefix of every Management API endpoint.
+const managementAPIBasePath = "/api/v2/"
+
+// responseCacheTTL is how long a cached response is served for. It only needs
+// to cover the reads of a single refresh, which happen in quick succession.
+const responseCacheTTL = 10 * time.Second
+
+// relatedCacheCollections lists, for a Management API collection, the other
+// collections whose responses can embed data changed by a mutation on it.
+// For example, adding a member to an organization changes the result of
+// GET /api/v2/users/{id}/organizations.
+var relatedCacheCollections = map[string][]string{
+	"users":            {"roles", "organizations"},
+	"roles":            {"users", "organizations"},
+	"organizations":    {"users", "roles", "clients"},
+	"clients":          {"client-grants", "connections", "organizations"},
+	"client-grants":    {"clients", "organizations"},
+	"connections":      {"clients", "organizations"},
+	"resource-servers": {"client-grants", "clients", "roles"},
+}
+
+// cachedResponse is a successful GET response kept in the responseCache.
+type cachedResponse struct {
+	statusCode int
+	header     http.Header
+	body       []byte
+	cachedAt   time.Time
+}
+
+// responseCache memoizes successful Management API GET responses for
+// responseCacheTTL. It is shared by the v1 and v3 clients of a provider
+// configuration, but not with its aliases.
+type responseCache struct {
+	mu         sync.Mutex
+	entries    map[string]*cachedResponse
+	generation uint64
+	now        func() time.Time
+}
+
+func newResponseCache() *responseCache {
+	return &responseCache{
+		entries: make(map[string]*cachedResponse),
+		now:     time.Now,
+	}
+}
+
+func (c *responseCache) get(key string) (*cachedResponse, uint64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	cached := c.entries[key]
+	if cached != nil && c.now().Sub(cached.cachedAt) >= responseCacheTTL {
+		delete(c.entries, key)
+		cached = nil
+	}
+
+	return cached, c.generation
+}
+
+// set stores the response unless a mutation happened since
+// the request was sent, as the response could then be stale.
+func (c *responseCache) set(key string, generation uint64, response *cachedResponse) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if generation != c.generation {
+		return
+	}
+
+	response.cachedAt = c.now()
+	c.entries[key] = response
+}
+
+// invalidate drops every cached response of the collection targeted by
+// the given path, as well as the responses of the related collections.
+func (c *responseCache) invalidate(host, urlPath string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.generation++
+
+	collection, ok := managementAPICollection(urlPath)
+	if !ok {
+		return
+	}
+
+	collections := append([]string{collection}, relatedCacheCollections[collection]...)
+	for key := range c.entries {
+		// Mutations made with any credential invalidate the responses of every credential.
+		_, resource, _ := strings.Cut(key, " ")
+
+		for _, related := range collections {
+			if strings.HasPrefix(resource, host+managementAPIBasePath+related+"/") ||
+				strings.HasPrefix(resource, host+managementAPIBasePath+related+"?") ||
+				resource == host+managementAPIBasePath+related {
+				delete(c.entries, key)
+				break
+			}
+		}
+	}
+}
+
+// managementAPICollection returns the first path segment after /api/v2/, e.g. "organizations".
+func managementAPICollection(urlPath string) (string, bool) {
+	if !strings.HasPrefix(urlPath, managementAPIBasePath) {
+		return "", false
+	}
+
+	collection, _, _ := strings.Cut(strings.TrimPrefix(urlPath, managementAPIBasePath), "/")
+
+	return collection, collection != ""
+}
+
+// responseCacheKey identifies the response to a GET request. It includes a hash
+// of the credential the request is sent with, as the response depends on it.
+func responseCacheKey(req *http.Request) string {
+	credential := sha256.Sum256([]byte(req.Header.Get("Authorization")))
+	return hex.EncodeToString(credential[:8]) + " " + req.URL.Host + req.URL.RequestURI()
+}
+
+// responseCacheTransport wraps an http.RoundTripper to serve repeated Management API
+// GET requests from the responseCache and invalidate it on any other request.
+// Requests polling an operation with the wait package always reach the API,
+// as they repeat the same request until the response changes.
+type responseCacheTransport struct {
+	transport http.RoundTripper
+	cache     *responseCache
+}
+
+func (t *responseCacheTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if !strings.HasPrefix(req.URL.Path, managementAPIBasePath) {
+		return t.transport.RoundTrip(req)
+	}
+
+	if req.Method != http.MethodGet {
+		resp, err := t.transport.RoundTrip(req)
+
+		// Invalidate even when the request failed, as
+		// the mutation might have been partially applied.
+		t.cache.invalidate(req.URL.Host, req.URL.Path)
+
+		return resp, err
+	}
+
+	if wait.IsPolling(req.Context()) {
+		return t.transport.RoundTrip(req)
+	}
+
+	key := responseCacheKey(req)
+
+	cached, generation := t.cache.get(key)
+	if cached != nil {
+		return cached.toResponse(req), nil
+	}
+
+	resp, err := t.transport.RoundTrip(req)
+	if err != nil || resp.StatusCode != http.StatusOK {
+		return resp, err
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	_ = resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	cached = &cachedResponse{
+		statusCode: resp.StatusCode,
+		header:     resp.Header.Clone(),
+		body:       body,
+	}
+	t.cache.set(key, generation, cached)
+
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+
+	return resp, nil
+}
+
+// toResponse builds a fresh *http.Response for the given request out of the cached response.
+func (c *cachedResponse) toResponse(req *http.Request) *http.Response {
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", c.statusCode, http.StatusText(c.statusCode)),
+		StatusCode:    c.statusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        c.header.Clone(),
+		Body:          io.NopCloser(bytes.NewReader(c.body)),
+		ContentLength: int64(len(c.body)),
+		Request:       req,
+	}
+}
+
+func newResponseCacheTransport(tripper http.RoundTripper, cache *responseCache) http.RoundTripper {
+	return &responseCacheTransport{
+		transport: tripper,
+		cache:     cache,
+	}
+}
@@ -0,0 +1,218 @@
+package config
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/auth0/terraform-provider-auth0/internal/wait"
+)
+
+func doRequest(t *testing.T, client *http.Client, method, url string) (int, string) {
+	t.Helper()
+
+	request, err := http.NewRequest(method, url, nil)
+	require.NoError(t, err)
+
+	response, err := client.Do(request)
+	require.NoError(t, err)
+	defer func() { _ = response.Body.Close() }()
+
+	body, err := io.ReadAll(response.Body)
+	require.NoError(t, err)
+
+	return response.StatusCode, string(body)
+}
+
+func TestResponseCacheTransport(t *testing.T) {
+	newTestServer := func(apiCalls map[string]int) *httptest.Server {
+		return httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+			apiCalls[request.Method+" "+request.URL.RequestURI()]++
+			if strings.HasSuffix(request.URL.Path, "/missing") {
+				writer.WriteHeader(http.StatusNotFound)
+				return
+			}
+			writer.Header().Set("Content-Type", "application/json")
+			_, _ = writer.Write([]byte(`{"path":"` + request.URL.Path + `"}`))
+		}))
+	}
+
+	t.Run("it serves repeated GET requests from the cache", func(t *testing.T) {
+		apiCalls := map[string]int{}
+		testServer := newTestServer(apiCalls)
+		t.Cleanup(testServer.Close)
+
+		client := &http.Client{Transport: newResponseCacheTransport(http.DefaultTransport, newResponseCache())}
+
+		for i := 0; i < 3; i++ {
+			status, body := doRequest(t, client, http.MethodGet, testServer.URL+"/api/v2/roles/rol_1")
+			assert.Equal(t, http.StatusOK, status)
+			assert.Equal(t, `{"path":"/api/v2/roles/rol_1"}`, body)
+		}
+
+		_, _ = doRequest(t, client, http.MethodGet, testServer.URL+"/api/v2/roles/rol_1?page=1")
+
+		assert.Equal(t, 1, apiCalls["GET /api/v2/roles/rol_1"])
+		assert.Equal(t, 1, apiCalls["GET /api/v2/roles/rol_1?page=1"])
+	})
+
+	t.Run("it does not cache unsuccessful responses or requests outside the Management API", func(t *testing.T) {
+		apiCalls := map[string]int{}
+		testServer := newTestServer(apiCalls)
+		t.Cleanup(testServer.Close)
+
+		client := &http.Client{Transport: newResponseCacheTransport(http.DefaultTransport, newResponseCache())}
+
+		for i := 0; i < 2; i++ {
+			status, _ := doRequest(t, client, http.MethodGet, testServer.URL+"/api/v2/roles/missing")
+			assert.Equal(t, http.StatusNotFound, status)
+
+			_, _ = doRequest(t, client, http.MethodGet, testServer.URL+"/userinfo")
+		}
+
+		assert.Equal(t, 2, apiCalls["GET /api/v2/roles/missing"])
+		assert.Equal(t, 2, apiCalls["GET /userinfo"])
+	})
+
+	t.Run("it invalidates the affected collections on mutations", func(t *testing.T) {
+		apiCalls := map[string]int{}
+		testServer := newTestServer(apiCalls)
+		t.Cleanup(testServer.Close)
+
+		client := &http.Client{Transport: newResponseCacheTransport(http.DefaultTransport, newResponseCache())}
+
+		paths := []string{
+			"/api/v2/organizations/org_1/members",
+			"/api/v2/users/auth0%7C1/organizations",
+			"/api/v2/resource-servers/rs_1",
+		}
+		for _, path := range paths {
+			_, _ = doRequest(t, client, http.MethodGet, testServer.URL+path)
+		}
+
+		_, _ = doRequest(t, client, http.MethodPost, testServer.URL+"/api/v2/organizations/org_1/members")
+
+		for _, path := range paths {
+			_, _ = doRequest(t, client, http.MethodGet, testServer.URL+path)
+		}
+
+		assert.Equal(t, 2, apiCalls["GET /api/v2/organizations/org_1/members"])
+		assert.Equal(t, 2, apiCalls["GET /api/v2/users/auth0%7C1/organizations"])
+		assert.Equal(t, 1, apiCalls["GET /api/v2/resource-servers/rs_1"])
+	})
+}
+
+func TestResponseCacheTransport_Freshness(t *testing.T) {
+	newTestServer := func(apiCalls *int) *httptest.Server {
+		return httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+			*apiCalls++
+			_, _ = writer.Write([]byte(request.Header.Get("Authorization")))
+		}))
+	}
+
+	t.Run("it expires the cached responses after the TTL", func(t *testing.T) {
+		apiCalls := 0
+		testServer := newTestServer(&apiCalls)
+		t.Cleanup(testServer.Close)
+
+		now := time.Now()
+		cache := newResponseCache()
+		cache.now = func() time.Time { return now }
+		client := &http.Client{Transport: newResponseCacheTransport(http.DefaultTransport, cache)}
+
+		_, _ = doRequest(t, client, http.MethodGet, testServer.URL+"/api/v2/actions/actions/act_1")
+		now = now.Add(responseCacheTTL - time.Second)
+		_, _ = doRequest(t, client, http.MethodGet, testServer.URL+"/api/v2/actions/actions/act_1")
+		assert.Equal(t, 1, apiCalls)
+
+		now = now.Add(time.Second)
+		_, _ = doRequest(t, client, http.MethodGet, testServer.URL+"/api/v2/actions/actions/act_1")
+		assert.Equal(t, 2, apiCalls)
+	})
+
+	t.Run("it skips the cache while polling an operation", func(t *testing.T) {
+		apiCalls := 0
+		testServer := newTestServer(&apiCalls)
+		t.Cleanup(testServer.Close)
+
+		client := &http.Client{Transport: newResponseCacheTransport(http.DefaultTransport, newResponseCache())}
+		_, _ = doRequest(t, client, http.MethodGet, testServer.URL+"/api/v2/actions/actions/act_1")
+
+		polls := 0
+		err := wait.Until(context.Background(), wait.Options{InitialInterval: time.Millisecond}, func(ctx context.Context) (bool, string, error) {
+			request, err := http.NewRequestWithContext(ctx, http.MethodGet, testServer.URL+"/api/v2/actions/actions/act_1", nil)
+			require.NoError(t, err)
+
+			response, err := client.Do(request)
+			require.NoError(t, err)
+			_ = response.Body.Close()
+
+			polls++
+			return polls == 3, "pending", nil
+		})
+		require.NoError(t, err)
+		assert.Equal(t, 4, apiCalls)
+	})
+
+	t.Run("it keeps the responses of each credential apart", func(t *testing.T) {
+		apiCalls := 0
+		testServer := newTestServer(&apiCalls)
+		t.Cleanup(testServer.Close)
+
+		client := &http.Client{Transport: newResponseCacheTransport(http.DefaultTransport, newResponseCache())}
+
+		for _, token := range []string{"first", "second", "first"} {
+			request, err := http.NewRequest(http.MethodGet, testServer.URL+"/api/v2/clients", nil)
+			require.NoError(t, err)
+			request.Header.Set("Authorization", "Bearer "+token)
+
+			response, err := client.Do(request)
+			require.NoError(t, err)
+
+			body, err := io.ReadAll(response.Body)
+			require.NoError(t, err)
+			_ = response.Body.Close()
+
+			assert.Equal(t, "Bearer "+token, string(body))
+		}
+
+		assert.Equal(t, 2, apiCalls)
+	})
+}
+
+func TestResponseCache_DoesNotStoreResponsesOutlivedByAMutation(t *testing.T) {
+	cache := newResponseCache()
+
+	_, generation := cache.get("example.auth0.com/api/v2/roles")
+	cache.invalidate("example.auth0.com", "/api/v2/roles/rol_1")
+	cache.set("example.auth0.com/api/v2/roles", generation, &cachedResponse{statusCode: http.StatusOK})
+
+	cached, _ := cache.get("example.auth0.com/api/v2/roles")
+	assert.Nil(t, cached)
+}
+
+func TestCustomClientWithRetries_CacheGetRequests(t *testing.T) {
+	apiCalls := 0
+	testServer := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, _ *http.Request) {
+		apiCalls++
+		writer.WriteHeader(http.StatusOK)
+	}))
+	t.Cleanup(testServer.Close)
+
+	uncached := customClientWithRetries(ProviderConfig{})
+	_, _ = doRequest(t, uncached, http.MethodGet, testServer.URL+"/api/v2/clients/uncached")
+	_, _ = doRequest(t, uncached, http.MethodGet, testServer.URL+"/api/v2/clients/uncached")
+	assert.Equal(t, 2, apiCalls)
+
+	cached := customClientWithRetries(ProviderConfig{CacheGetRequests: true})
+	_, _ = doRequest(t, cached, http.MethodGet, testServer.URL+"/api/v2/clients/cached")
+	_, _ = doRequest(t, cached, http.MethodGet, testServer.URL+"/api/v2/clients/cached")
+	assert.Equal(t, 3, apiCalls)
+}
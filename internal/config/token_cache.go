@@ -0,0 +1,244 @@
+package config
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"time"
+
+	"github.com/auth0/go-auth0/authentication"
+	"github.com/auth0/go-auth0/authentication/oauth"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"golang.org/x/oauth2"
+)
+
+// tokenCacheExpiryMargin is how long before its expiry a cached access token
+// stops being reused. The token source refreshes the token in the middle of
+// a run anyway, so the margin only needs to cover the requests in flight.
+const tokenCacheExpiryMargin = 5 * time.Minute
+
+// tokenCacheEntry is an access token stored in the token cache file.
+type tokenCacheEntry struct {
+	AccessToken string    `json:"access_token"`
+	ExpiresAt   time.Time `json:"expires_at"`
+}
+
+// tokenCacheFile is the content of the token cache file, keyed by tokenCacheKey.
+type tokenCacheFile struct {
+	Tokens map[string]tokenCacheEntry `json:"tokens"`
+}
+
+// mintClientCredentialsToken performs the client credentials grant for the given configuration.
+// It is a variable so that tests can replace it with a fake token endpoint.
+var mintClientCredentialsToken = func(ctx context.Context, cfg ProviderConfig) (*oauth2.Token, error) {
+	options := []authentication.Option{
+		authentication.WithClientID(cfg.ClientID),
+		authentication.WithClient(customClientWithRetries(cfg)),
+	}
+
+	if cfg.ClientAssertionPrivateKey != "" {
+		options = append(options, authentication.WithClientAssertion(
+			cfg.ClientAssertionPrivateKey,
+			cfg.ClientAssertionSigningAlg,
+		))
+	} else {
+		options = append(options, authentication.WithClientSecret(cfg.ClientSecret))
+	}
+
+	authAPI, err := authentication.New(ctx, cfg.Domain, options...)
+	if err != nil {
+		return nil, err
+	}
+
+	tokenSet, err := authAPI.OAuth.LoginWithClientCredentials(
+		ctx,
+		oauth.LoginWithClientCredentialsRequest{Audience: tokenAudience(cfg)},
+		oauth.IDTokenValidationOptions{},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	expiresAt, err := accessTokenExpiry(tokenSet.AccessToken, tokenSet.ExpiresIn)
+	if err != nil {
+		return nil, err
+	}
+
+	return &oauth2.Token{AccessToken: tokenSet.AccessToken, TokenType: "Bearer", Expiry: expiresAt}, nil
+}
+
+// accessTokenExpiry returns when an access token expires, using the expires_in of the token
+// endpoint response. Access tokens aren't always JWTs, they can be opaque or encrypted, so the
+// exp claim of the token is only used when the response doesn't have an expires_in.
+func accessTokenExpiry(accessToken string, expiresIn int64) (time.Time, error) {
+	if expiresIn > 0 {
+		return time.Now().Add(time.Duration(expiresIn) * time.Second), nil
+	}
+
+	return tokenExpiresAt(accessToken)
+}
+
+// tokenAudience returns the audience requested for the Management API access token.
+func tokenAudience(cfg ProviderConfig) string {
+	if cfg.Audience != "" {
+		return cfg.Audience
+	}
+
+	domain := cfg.Domain
+	if i := strings.Index(domain, "//"); i != -1 {
+		domain = domain[i+2:]
+	}
+
+	return "https://" + domain + "/api/v2/"
+}
+
+// tokenCacheKey identifies the cached access token of a given client credentials configuration.
+func tokenCacheKey(cfg ProviderConfig) string {
+	return strings.Join([]string{cfg.Domain, cfg.ClientID, tokenAudience(cfg)}, "|")
+}
+
+// tokenCacheSource is an oauth2.TokenSource getting the access tokens
+// for the client credentials of a configuration from its token cache.
+type tokenCacheSource struct {
+	ctx context.Context
+	cfg ProviderConfig
+}
+
+func (s *tokenCacheSource) Token() (*oauth2.Token, error) {
+	return cachedClientCredentialsToken(s.ctx, s.cfg)
+}
+
+// newTokenCacheSource returns a token source keeping the client credentials flow of the
+// configuration, seeded with the tokens of its token cache. The token is refreshed, and the
+// cache updated, once the token nears its expiry, so that long runs outlive their first token.
+func newTokenCacheSource(ctx context.Context, cfg ProviderConfig) oauth2.TokenSource {
+	// The tokens are refreshed long after the provider got configured.
+	ctx = context.WithoutCancel(ctx)
+
+	return oauth2.ReuseTokenSourceWithExpiry(nil, &tokenCacheSource{ctx: ctx, cfg: cfg}, tokenCacheExpiryMargin)
+}
+
+// cachedClientCredentialsToken returns an access token for the client credentials of the
+// configuration. It reuses the token stored in the cache file at cfg.TokenCachePath while
+// it is valid for longer than tokenCacheExpiryMargin, and otherwise mints and stores a new one.
+func cachedClientCredentialsToken(ctx context.Context, cfg ProviderConfig) (*oauth2.Token, error) {
+	key := tokenCacheKey(cfg)
+
+	cache, err := readTokenCacheFile(cfg.TokenCachePath)
+	if err != nil {
+		// A corrupted or unsafe cache is not fatal, it just gets overwritten.
+		tflog.Warn(ctx, "Ignoring the access token cache file", map[string]interface{}{
+			"path":  cfg.TokenCachePath,
+			"error": err.Error(),
+		})
+		cache = &tokenCacheFile{}
+	}
+
+	if cache.Tokens == nil {
+		cache.Tokens = map[string]tokenCacheEntry{}
+	}
+
+	if entry, ok := cache.Tokens[key]; ok && time.Until(entry.ExpiresAt) > tokenCacheExpiryMargin {
+		tflog.Debug(ctx, "Reusing cached Management API access token", map[string]interface{}{
+			"expires_at": entry.ExpiresAt.Format(time.RFC3339),
+		})
+		return &oauth2.Token{AccessToken: entry.AccessToken, TokenType: "Bearer", Expiry: entry.ExpiresAt}, nil
+	}
+
+	token, err := mintClientCredentialsToken(ctx, cfg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get an access token using the client credentials: %w", err)
+	}
+
+	cache.Tokens[key] = tokenCacheEntry{
+		AccessToken: token.AccessToken,
+		ExpiresAt:   token.Expiry,
+	}
+
+	// Drop the tokens that expired in the meantime so the file doesn't grow forever.
+	for cachedKey, entry := range cache.Tokens {
+		if time.Now().After(entry.ExpiresAt) {
+			delete(cache.Tokens, cachedKey)
+		}
+	}
+
+	if err := writeTokenCacheFile(cfg.TokenCachePath, cache); err != nil {
+		tflog.Warn(ctx, "Failed to write the access token cache file", map[string]interface{}{
+			"path":  cfg.TokenCachePath,
+			"error": err.Error(),
+		})
+	}
+
+	return token, nil
+}
+
+// readTokenCacheFile reads the token cache file, refusing to use it when it can
+// be read by other users than its owner. Windows doesn't have Unix permissions,
+// the file is protected by the ACL of the user's directories instead.
+func readTokenCacheFile(path string) (*tokenCacheFile, error) {
+	info, err := os.Stat(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return &tokenCacheFile{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if runtime.GOOS != "windows" && info.Mode().Perm()&0o077 != 0 {
+		return nil, fmt.Errorf("the token cache file %q must only be accessible by its owner (0600), "+
+			"found %#o", path, info.Mode().Perm())
+	}
+
+	buffer, err := os.ReadFile(filepath.Clean(path))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the token cache file: %w", err)
+	}
+
+	cache := &tokenCacheFile{}
+	if err := json.Unmarshal(buffer, cache); err != nil {
+		return nil, fmt.Errorf("failed to parse the token cache file: %w", err)
+	}
+
+	return cache, nil
+}
+
+// writeTokenCacheFile atomically replaces the token cache
+// file with one only accessible by the current user.
+func writeTokenCacheFile(path string, cache *tokenCacheFile) error {
+	buffer, err := json.Marshal(cache)
+	if err != nil {
+		return err
+	}
+
+	directory := filepath.Dir(path)
+	if err := os.MkdirAll(directory, 0o700); err != nil {
+		return err
+	}
+
+	tempFile, err := os.CreateTemp(directory, ".auth0-token-cache-*")
+	if err != nil {
+		return err
+	}
+	defer func() { _ = os.Remove(tempFile.Name()) }()
+
+	if err := tempFile.Chmod(0o600); err != nil {
+		_ = tempFile.Close()
+		return err
+	}
+
+	if _, err := tempFile.Write(buffer); err != nil {
+		_ = tempFile.Close()
+		return err
+	}
+
+	if err := tempFile.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(tempFile.Name(), path)
+}
@@ -0,0 +1,225 @@
+package config
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/lestrrat-go/jwx/v2/jwt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/oauth2"
+)
+
+func createJwtToken(t *testing.T, expiresIn time.Duration) string {
+	t.Helper()
+
+	token, err := jwt.NewBuilder().
+		JwtID(strconv.FormatInt(time.Now().UnixNano(), 10)).
+		Expiration(time.Now().Add(expiresIn)).
+		Build()
+	require.NoError(t, err)
+
+	signed, err := jwt.Sign(token, jwt.WithInsecureNoSignature())
+	require.NoError(t, err)
+
+	return string(signed)
+}
+
+func stubMintClientCredentialsToken(t *testing.T, expiresIn time.Duration) *int {
+	t.Helper()
+
+	calls := 0
+	original := mintClientCredentialsToken
+	mintClientCredentialsToken = func(_ context.Context, _ ProviderConfig) (*oauth2.Token, error) {
+		calls++
+		return &oauth2.Token{
+			AccessToken: createJwtToken(t, expiresIn),
+			TokenType:   "Bearer",
+			Expiry:      time.Now().Add(expiresIn),
+		}, nil
+	}
+	t.Cleanup(func() { mintClientCredentialsToken = original })
+
+	return &calls
+}
+
+func TestCachedClientCredentialsToken(t *testing.T) {
+	t.Run("it reuses a cached token until it nears expiry", func(t *testing.T) {
+		calls := stubMintClientCredentialsToken(t, 24*time.Hour)
+		cfg := ProviderConfig{
+			Domain:         "example.auth0.com",
+			ClientID:       "client-id",
+			ClientSecret:   "secret",
+			TokenCachePath: filepath.Join(t.TempDir(), "cache", "tokens.json"),
+		}
+
+		first, err := cachedClientCredentialsToken(context.Background(), cfg)
+		require.NoError(t, err)
+
+		second, err := cachedClientCredentialsToken(context.Background(), cfg)
+		require.NoError(t, err)
+
+		assert.Equal(t, first.AccessToken, second.AccessToken)
+		assert.Equal(t, 1, *calls)
+
+		info, err := os.Stat(cfg.TokenCachePath)
+		require.NoError(t, err)
+		assert.Equal(t, os.FileMode(0o600), info.Mode().Perm())
+	})
+
+	t.Run("it reuses short-lived tokens", func(t *testing.T) {
+		calls := stubMintClientCredentialsToken(t, time.Hour)
+		cfg := ProviderConfig{
+			Domain:         "example.auth0.com",
+			ClientID:       "client-id",
+			ClientSecret:   "secret",
+			TokenCachePath: filepath.Join(t.TempDir(), "tokens.json"),
+		}
+
+		_, err := cachedClientCredentialsToken(context.Background(), cfg)
+		require.NoError(t, err)
+		_, err = cachedClientCredentialsToken(context.Background(), cfg)
+		require.NoError(t, err)
+
+		assert.Equal(t, 1, *calls)
+	})
+
+	t.Run("it mints a new token when the cached one nears expiry", func(t *testing.T) {
+		calls := stubMintClientCredentialsToken(t, tokenCacheExpiryMargin-time.Minute)
+		cfg := ProviderConfig{
+			Domain:         "example.auth0.com",
+			ClientID:       "client-id",
+			ClientSecret:   "secret",
+			TokenCachePath: filepath.Join(t.TempDir(), "tokens.json"),
+		}
+
+		_, err := cachedClientCredentialsToken(context.Background(), cfg)
+		require.NoError(t, err)
+		_, err = cachedClientCredentialsToken(context.Background(), cfg)
+		require.NoError(t, err)
+
+		assert.Equal(t, 2, *calls)
+	})
+
+	t.Run("it keys cached tokens by domain, client ID and audience", func(t *testing.T) {
+		calls := stubMintClientCredentialsToken(t, 24*time.Hour)
+		path := filepath.Join(t.TempDir(), "tokens.json")
+
+		configs := []ProviderConfig{
+			{Domain: "example.auth0.com", ClientID: "client-id", TokenCachePath: path},
+			{Domain: "example.auth0.com", ClientID: "other-client-id", TokenCachePath: path},
+			{Domain: "example.auth0.com", ClientID: "client-id", Audience: "https://other/api/v2/", TokenCachePath: path},
+			{Domain: "other.auth0.com", ClientID: "client-id", TokenCachePath: path},
+		}
+		for _, cfg := range configs {
+			_, err := cachedClientCredentialsToken(context.Background(), cfg)
+			require.NoError(t, err)
+		}
+		for _, cfg := range configs {
+			_, err := cachedClientCredentialsToken(context.Background(), cfg)
+			require.NoError(t, err)
+		}
+
+		assert.Equal(t, len(configs), *calls)
+
+		cache, err := readTokenCacheFile(path)
+		require.NoError(t, err)
+		assert.Len(t, cache.Tokens, len(configs))
+	})
+
+	t.Run("it ignores a cache file readable by other users", func(t *testing.T) {
+		if runtime.GOOS == "windows" {
+			t.Skip("Windows doesn't have Unix file permissions")
+		}
+
+		calls := stubMintClientCredentialsToken(t, 24*time.Hour)
+		cfg := ProviderConfig{
+			Domain:         "example.auth0.com",
+			ClientID:       "client-id",
+			TokenCachePath: filepath.Join(t.TempDir(), "tokens.json"),
+		}
+
+		_, err := cachedClientCredentialsToken(context.Background(), cfg)
+		require.NoError(t, err)
+
+		require.NoError(t, os.Chmod(cfg.TokenCachePath, 0o644))
+
+		_, err = readTokenCacheFile(cfg.TokenCachePath)
+		assert.ErrorContains(t, err, "must only be accessible by its owner")
+
+		_, err = cachedClientCredentialsToken(context.Background(), cfg)
+		require.NoError(t, err)
+		assert.Equal(t, 2, *calls)
+
+		info, err := os.Stat(cfg.TokenCachePath)
+		require.NoError(t, err)
+		assert.Equal(t, os.FileMode(0o600), info.Mode().Perm())
+	})
+}
+
+func TestTokenCacheSource(t *testing.T) {
+	sendRequests := func(t *testing.T, source oauth2.TokenSource, count int) []string {
+		t.Helper()
+
+		var tokens []string
+		testServer := httptest.NewServer(http.HandlerFunc(func(_ http.ResponseWriter, request *http.Request) {
+			tokens = append(tokens, request.Header.Get("Authorization"))
+		}))
+		t.Cleanup(testServer.Close)
+
+		client := &http.Client{Transport: newTokenSourceTransport(http.DefaultTransport, source)}
+		for i := 0; i < count; i++ {
+			request, err := http.NewRequest(http.MethodGet, testServer.URL, nil)
+			require.NoError(t, err)
+			request.Header.Set("Authorization", "Bearer static-token")
+
+			response, err := client.Do(request)
+			require.NoError(t, err)
+			_ = response.Body.Close()
+		}
+
+		return tokens
+	}
+
+	newConfig := func(t *testing.T) ProviderConfig {
+		return ProviderConfig{
+			Domain:         "example.auth0.com",
+			ClientID:       "client-id",
+			TokenCachePath: filepath.Join(t.TempDir(), "tokens.json"),
+		}
+	}
+
+	t.Run("it sends the cached token until it nears expiry", func(t *testing.T) {
+		calls := stubMintClientCredentialsToken(t, time.Hour)
+
+		tokens := sendRequests(t, newTokenCacheSource(context.Background(), newConfig(t)), 2)
+
+		require.Len(t, tokens, 2)
+		assert.Equal(t, tokens[0], tokens[1])
+		assert.NotEqual(t, "Bearer static-token", tokens[0])
+		assert.Equal(t, 1, *calls)
+	})
+
+	t.Run("it refreshes the token once it nears expiry", func(t *testing.T) {
+		calls := stubMintClientCredentialsToken(t, tokenCacheExpiryMargin-time.Minute)
+
+		tokens := sendRequests(t, newTokenCacheSource(context.Background(), newConfig(t)), 2)
+
+		require.Len(t, tokens, 2)
+		assert.NotEqual(t, tokens[0], tokens[1])
+		assert.Equal(t, 2, *calls)
+	})
+}
+
+func TestTokenAudience(t *testing.T) {
+	assert.Equal(t, "https://example.auth0.com/api/v2/", tokenAudience(ProviderConfig{Domain: "example.auth0.com"}))
+	assert.Equal(t, "https://example.auth0.com/api/v2/", tokenAudience(ProviderConfig{Domain: "https://example.auth0.com"}))
+	assert.Equal(t, "my-audience", tokenAudience(ProviderConfig{Domain: "example.auth0.com", Audience: "my-audience"}))
+}
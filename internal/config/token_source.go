@@ -0,0 +1,39 @@
+package config
+
+import (
+	"net/http"
+	"strings"
+
+	"golang.org/x/oauth2"
+)
+
+// tokenSourceTransport wraps an http.RoundTripper to replace the bearer token set by the SDKs,
+// which only know the token they were configured with, with the current token of the source,
+// so that long-running operations keep working past the expiry of the first token.
+type tokenSourceTransport struct {
+	transport http.RoundTripper
+	source    oauth2.TokenSource
+}
+
+func (t *tokenSourceTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if !strings.HasPrefix(req.Header.Get("Authorization"), "Bearer ") {
+		return t.transport.RoundTrip(req)
+	}
+
+	token, err := t.source.Token()
+	if err != nil {
+		return nil, err
+	}
+
+	req = req.Clone(req.Context())
+	token.SetAuthHeader(req)
+
+	return t.transport.RoundTrip(req)
+}
+
+func newTokenSourceTransport(tripper http.RoundTripper, source oauth2.TokenSource) http.RoundTripper {
+	return &tokenSourceTransport{
+		transport: tripper,
+		source:    source,
+	}
+}
@@ -0,0 +1,117 @@
+package error
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// AdoptExistingAttribute is the name of the resource attribute overriding
+// the adopt_existing setting of the provider for a single resource.
+const AdoptExistingAttribute = "adopt_existing"
+
+// AdoptExistingSchema returns the schema of the adopt_existing attribute
+// of the resources able to adopt the objects that already exist.
+func AdoptExistingSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeBool,
+		Optional: true,
+		Description: "Adopts the object if it already exists in the tenant instead of failing to create it, " +
+			"overriding the `adopt_existing` setting of the provider for this resource. " +
+			"It is only used when creating the resource.",
+	}
+}
+
+// AdoptExistingEnabled checks whether the resource adopts an object that
+// already exists, as set by its adopt_existing attribute, falling back to
+// the setting of the provider when the attribute isn't configured.
+func AdoptExistingEnabled(data *schema.ResourceData, providerDefault bool) bool {
+	rawConfig := data.GetRawConfig()
+	if rawConfig.IsNull() || !rawConfig.IsKnown() {
+		return providerDefault
+	}
+
+	value := rawConfig.GetAttr(AdoptExistingAttribute)
+	if value.IsNull() || !value.IsKnown() {
+		return providerDefault
+	}
+
+	return value.True()
+}
+
+// AdoptExisting handles the error of a create operation. When the Management
+// API returns a 409 because the object already exists and adopting existing
+// objects is enabled, it looks up the ID of the existing object by its natural
+// key with findID and sets it on the resource, so that the create can carry
+// on by applying the planned configuration as an update.
+//
+// It returns true when the conflict was handled, along with a warning about
+// the adopted object, or the error of the lookup. It returns false for any
+// other error, or when adopting existing objects is disabled.
+//
+// The resourceType is the Terraform type of the resource being created
+// (e.g. "auth0_role") and the naturalKey describes how the existing object
+// was found (e.g. `name "Admin"`).
+func AdoptExisting(
+	ctx context.Context,
+	resourceType string,
+	naturalKey string,
+	data *schema.ResourceData,
+	err error,
+	enabled bool,
+	findID func(ctx context.Context) (string, error),
+) (diag.Diagnostics, bool) {
+	if !enabled || !IsStatusConflict(err) {
+		return nil, false
+	}
+
+	id, findErr := findID(ctx)
+	if findErr != nil {
+		return diag.Errorf(
+			"the %s with %s already exists, but it couldn't be adopted: %s. Original error: %s",
+			resourceType,
+			naturalKey,
+			findErr,
+			err,
+		), true
+	}
+
+	tflog.Info(ctx, "Adopting the existing object instead of creating it", map[string]interface{}{
+		"resource_type": resourceType,
+		"natural_key":   naturalKey,
+		"id":            id,
+	})
+
+	data.SetId(id)
+
+	return diag.Diagnostics{{
+		Severity: diag.Warning,
+		Summary:  "Existing object adopted",
+		Detail: fmt.Sprintf(
+			"The %s with %s already existed in Auth0, so it was adopted into the Terraform state with "+
+				"the ID %q instead of being created, and updated to match the configuration, "+
+				"because adopt_existing is enabled. Destroying the resource will delete the object.",
+			resourceType, naturalKey, id,
+		),
+	}}, true
+}
+
+// CheckAdoptableAttribute returns an error when an attribute of an existing
+// object differs from its configured value while the Management API only
+// accepts it on creation, as adopting the object would then plan to replace it.
+func CheckAdoptableAttribute(attribute, existingValue, configuredValue string) error {
+	if existingValue == configuredValue {
+		return nil
+	}
+
+	return fmt.Errorf(
+		"its %s is %q instead of %q, and the %s can only be set when creating it",
+		attribute,
+		existingValue,
+		configuredValue,
+		attribute,
+	)
+}
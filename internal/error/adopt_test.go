@@ -0,0 +1,222 @@
+package error
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAdoptExisting(t *testing.T) {
+	conflictErr := testManagementError{StatusCode: http.StatusConflict}
+
+	findID := func(id string, err error) func(context.Context) (string, error) {
+		return func(context.Context) (string, error) {
+			return id, err
+		}
+	}
+
+	t.Run("it doesn't adopt when adopting existing objects is disabled", func(t *testing.T) {
+		data := schema.TestResourceDataRaw(t, nil, nil)
+
+		diagnostics, adopted := AdoptExisting(
+			context.Background(), "auth0_role", `name "Admin"`, data, conflictErr, false,
+			func(context.Context) (string, error) {
+				t.Fatal("The existing object shouldn't be looked up.")
+				return "", nil
+			},
+		)
+		assert.False(t, adopted)
+		assert.Nil(t, diagnostics)
+		assert.Empty(t, data.Id())
+	})
+
+	t.Run("it doesn't adopt when the error isn't a conflict", func(t *testing.T) {
+		data := schema.TestResourceDataRaw(t, nil, nil)
+
+		diagnostics, adopted := AdoptExisting(
+			context.Background(), "auth0_role", `name "Admin"`, data,
+			testManagementError{StatusCode: http.StatusBadRequest}, true, findID("rol_123", nil),
+		)
+		assert.False(t, adopted)
+		assert.Nil(t, diagnostics)
+		assert.Empty(t, data.Id())
+	})
+
+	t.Run("it adopts the existing object on a conflict", func(t *testing.T) {
+		data := schema.TestResourceDataRaw(t, nil, nil)
+
+		diagnostics, adopted := AdoptExisting(
+			context.Background(), "auth0_role", `name "Admin"`, data, conflictErr, true, findID("rol_123", nil),
+		)
+		assert.True(t, adopted)
+		assert.Equal(t, "rol_123", data.Id())
+
+		require.Len(t, diagnostics, 1)
+		assert.Equal(t, diag.Warning, diagnostics[0].Severity)
+		assert.Equal(t, "Existing object adopted", diagnostics[0].Summary)
+		assert.Contains(t, diagnostics[0].Detail, `The auth0_role with name "Admin" already existed in Auth0`)
+		assert.Contains(t, diagnostics[0].Detail, `with the ID "rol_123"`)
+	})
+
+	t.Run("it returns an error when the existing object can't be found", func(t *testing.T) {
+		data := schema.TestResourceDataRaw(t, nil, nil)
+
+		diagnostics, adopted := AdoptExisting(
+			context.Background(), "auth0_role", `name "Admin"`, data, conflictErr, true,
+			findID("", errors.New(`found 2 roles with name "Admin"`)),
+		)
+		assert.True(t, adopted)
+		assert.Empty(t, data.Id())
+		require.True(t, diagnostics.HasError())
+		assert.Equal(
+			t,
+			`the auth0_role with name "Admin" already exists, but it couldn't be adopted: `+
+				`found 2 roles with name "Admin". Original error: 409`,
+			diagnostics[0].Summary,
+		)
+	})
+}
+
+func TestCheckAdoptableAttribute(t *testing.T) {
+	var testCases = []struct {
+		name            string
+		attribute       string
+		existingValue   string
+		configuredValue string
+		expectedError   string
+	}{
+		{
+			name:            "it accepts an existing custom domain of the configured type",
+			attribute:       "type",
+			existingValue:   "auth0_managed_certs",
+			configuredValue: "auth0_managed_certs",
+		},
+		{
+			name:            "it rejects an existing custom domain of another type",
+			attribute:       "type",
+			existingValue:   "self_managed_certs",
+			configuredValue: "auth0_managed_certs",
+			expectedError: `its type is "self_managed_certs" instead of "auth0_managed_certs", ` +
+				`and the type can only be set when creating it`,
+		},
+		{
+			name:            "it rejects an existing role of another type",
+			attribute:       "type",
+			existingValue:   "organization",
+			configuredValue: "tenant",
+			expectedError: `its type is "organization" instead of "tenant", ` +
+				`and the type can only be set when creating it`,
+		},
+		{
+			name:            "it rejects an existing role of another owner",
+			attribute:       "owner_id",
+			existingValue:   "org_456",
+			configuredValue: "org_123",
+			expectedError: `its owner_id is "org_456" instead of "org_123", ` +
+				`and the owner_id can only be set when creating it`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			err := CheckAdoptableAttribute(testCase.attribute, testCase.existingValue, testCase.configuredValue)
+			if testCase.expectedError == "" {
+				assert.NoError(t, err)
+				return
+			}
+
+			assert.EqualError(t, err, testCase.expectedError)
+		})
+	}
+
+	t.Run("it doesn't adopt a role of another owner", func(t *testing.T) {
+		data := schema.TestResourceDataRaw(t, nil, nil)
+
+		diagnostics, adopted := AdoptExisting(
+			context.Background(), "auth0_role", `name "Admin"`, data,
+			testManagementError{StatusCode: http.StatusConflict}, true,
+			func(context.Context) (string, error) {
+				return "", CheckAdoptableAttribute("owner_id", "org_456", "org_123")
+			},
+		)
+		assert.True(t, adopted)
+		assert.Empty(t, data.Id())
+		require.True(t, diagnostics.HasError())
+		assert.Equal(
+			t,
+			`the auth0_role with name "Admin" already exists, but it couldn't be adopted: `+
+				`its owner_id is "org_456" instead of "org_123", and the owner_id can only be set `+
+				`when creating it. Original error: 409`,
+			diagnostics[0].Summary,
+		)
+	})
+}
+
+func TestAdoptExistingEnabled(t *testing.T) {
+	resourceSchema := map[string]*schema.Schema{
+		"name":                 {Type: schema.TypeString, Required: true},
+		AdoptExistingAttribute: AdoptExistingSchema(),
+	}
+
+	var testCases = []struct {
+		name            string
+		adoptExisting   cty.Value
+		providerDefault bool
+		expected        bool
+	}{
+		{
+			name:            "it uses the provider setting when the attribute isn't set",
+			adoptExisting:   cty.NullVal(cty.Bool),
+			providerDefault: true,
+			expected:        true,
+		},
+		{
+			name:            "it uses the provider setting when the attribute is unknown",
+			adoptExisting:   cty.UnknownVal(cty.Bool),
+			providerDefault: false,
+			expected:        false,
+		},
+		{
+			name:            "it enables adopting when the provider setting is disabled",
+			adoptExisting:   cty.True,
+			providerDefault: false,
+			expected:        true,
+		},
+		{
+			name:            "it disables adopting when the provider setting is enabled",
+			adoptExisting:   cty.False,
+			providerDefault: true,
+			expected:        false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			data, err := schema.InternalMap(resourceSchema).Data(nil, &terraform.InstanceDiff{
+				RawConfig: cty.ObjectVal(map[string]cty.Value{
+					"id":                   cty.NullVal(cty.String),
+					"name":                 cty.StringVal("Admin"),
+					AdoptExistingAttribute: testCase.adoptExisting,
+				}),
+			})
+			require.NoError(t, err)
+
+			assert.Equal(t, testCase.expected, AdoptExistingEnabled(data, testCase.providerDefault))
+		})
+	}
+
+	t.Run("it uses the provider setting without a configuration", func(t *testing.T) {
+		data := schema.TestResourceDataRaw(t, resourceSchema, nil)
+
+		assert.True(t, AdoptExistingEnabled(data, true))
+		assert.False(t, AdoptExistingEnabled(data, false))
+	})
+}
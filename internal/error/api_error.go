@@ -70,19 +70,30 @@ func RemoveFromStateWithWarning(resourceType string, data *schema.ResourceData,
 // management.Error interface, and the v3 SDK error types, which wrap a *core.APIError
 // carrying the status code (e.g. *management.NotFoundError).
 func IsStatusNotFound(err error) bool {
+	return hasStatus(err, http.StatusNotFound)
+}
+
+// IsStatusConflict checks to see if the error from the Auth0 Management API is a 409,
+// returned when creating an object that already exists. Like IsStatusNotFound, it
+// understands both the v1 and the v3 SDK error types.
+func IsStatusConflict(err error) bool {
+	return hasStatus(err, http.StatusConflict)
+}
+
+func hasStatus(err error, statusCode int) bool {
 	if err == nil {
 		return false
 	}
 
 	// V1 SDK: errors implement management.Error with a Status() method.
 	var mErr management.Error
-	if errors.As(err, &mErr) && mErr.Status() == http.StatusNotFound {
+	if errors.As(err, &mErr) && mErr.Status() == statusCode {
 		return true
 	}
 
 	// V3 SDK: errors embed *core.APIError, which holds the status code in a field.
 	var apiErr *core.APIError
-	if errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusNotFound {
+	if errors.As(err, &apiErr) && apiErr.StatusCode == statusCode {
 		return true
 	}
 
@@ -217,3 +217,45 @@ func TestIsStatusNotFound(t *testing.T) {
 		})
 	}
 }
+
+func TestIsStatusConflict(t *testing.T) {
+	testCases := []struct {
+		name     string
+		givenErr error
+		expected bool
+	}{
+		{
+			name:     "nil error",
+			givenErr: nil,
+			expected: false,
+		},
+		{
+			name:     "v1 SDK 409",
+			givenErr: testManagementError{StatusCode: http.StatusConflict},
+			expected: true,
+		},
+		{
+			name:     "v1 SDK 404",
+			givenErr: testManagementError{StatusCode: http.StatusNotFound},
+			expected: false,
+		},
+		{
+			name: "v3 SDK 409 wrapped by fmt.Errorf",
+			givenErr: fmt.Errorf("creating role: %w", &managementv3.ConflictError{
+				APIError: core.NewAPIError(http.StatusConflict, nil, errors.New("conflict")),
+			}),
+			expected: true,
+		},
+		{
+			name:     "plain error",
+			givenErr: errors.New("409"),
+			expected: false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			assert.Equal(t, testCase.expected, IsStatusConflict(testCase.givenErr))
+		})
+	}
+}
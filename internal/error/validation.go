@@ -0,0 +1,286 @@
+package error
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/auth0/go-auth0/management"
+	managementv3 "github.com/auth0/go-auth0/v3/management"
+	"github.com/auth0/go-auth0/v3/management/core"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// propertyPatterns match the properties the Management API
+// names in the message of a payload validation error, e.g.
+// "Payload validation error: 'Invalid value "foo" (property: options.signInEndpoint)'"
+// or "Payload validation error: 'Expected type string but found type integer' on property name".
+var propertyPatterns = []*regexp.Regexp{
+	regexp.MustCompile(`\(property: ([\w.\[\]$-]+)\)`),
+	regexp.MustCompile(`on property ([\w.\[\]$-]+)`),
+}
+
+// APIErrorBody is the body of an error returned by the Management API.
+type APIErrorBody struct {
+	StatusCode int    `json:"statusCode"`
+	Error      string `json:"error"`
+	Message    string `json:"message"`
+	ErrorCode  string `json:"errorCode"`
+}
+
+// Properties returns the JSON paths of the payload
+// properties named in the message of the error.
+func (body APIErrorBody) Properties() []string {
+	var properties []string
+	seen := map[string]bool{}
+
+	for _, pattern := range propertyPatterns {
+		for _, match := range pattern.FindAllStringSubmatch(body.Message, -1) {
+			property := strings.TrimSuffix(match[1], ".")
+			if property == "" || seen[property] {
+				continue
+			}
+
+			seen[property] = true
+			properties = append(properties, property)
+		}
+	}
+
+	return properties
+}
+
+// ParseAPIError extracts the body of an error returned by the Management API,
+// whether it comes from the v1 SDK, implementing management.Error, or from the
+// v3 SDK, wrapping a *core.APIError. It returns false for any other error.
+func ParseAPIError(err error) (APIErrorBody, bool) {
+	var v1Err management.Error
+	if errors.As(err, &v1Err) {
+		var body APIErrorBody
+		if payload, marshalErr := json.Marshal(v1Err); marshalErr == nil {
+			_ = json.Unmarshal(payload, &body)
+		}
+
+		body.StatusCode = v1Err.Status()
+		if body.Message == "" {
+			body.Message = v1Err.Error()
+		}
+
+		return body, true
+	}
+
+	var apiErr *core.APIError
+	if errors.As(err, &apiErr) {
+		var body APIErrorBody
+		if rawBody := v3ErrorBody(err); rawBody != nil {
+			if payload, marshalErr := json.Marshal(rawBody); marshalErr == nil {
+				_ = json.Unmarshal(payload, &body)
+			}
+		} else if wrapped := apiErr.Unwrap(); wrapped != nil {
+			_ = json.Unmarshal([]byte(wrapped.Error()), &body)
+		}
+
+		body.StatusCode = apiErr.StatusCode
+		if body.Message == "" {
+			body.Message = apiErr.Error()
+		}
+
+		return body, true
+	}
+
+	return APIErrorBody{}, false
+}
+
+// v3ErrorBody returns the decoded body of the v3 SDK error types
+// the Management API returns on a create or update, if any.
+func v3ErrorBody(err error) interface{} {
+	var badRequestErr *managementv3.BadRequestError
+	if errors.As(err, &badRequestErr) {
+		return badRequestErr.Body
+	}
+
+	var conflictErr *managementv3.ConflictError
+	if errors.As(err, &conflictErr) {
+		return conflictErr.Body
+	}
+
+	var forbiddenErr *managementv3.ForbiddenError
+	if errors.As(err, &forbiddenErr) {
+		return forbiddenErr.Body
+	}
+
+	var notFoundErr *managementv3.NotFoundError
+	if errors.As(err, &notFoundErr) {
+		return notFoundErr.Body
+	}
+
+	return nil
+}
+
+// AttributePaths translates the JSON paths of the properties in the Management
+// API payload of a resource into paths of the attributes in its schema. Each
+// segment of a property is converted to snake case and looked up in the schema,
+// stepping into blocks, and the properties listed in Overrides are translated
+// to the given dot separated attribute paths first, e.g. "options.signSAMLRequest"
+// to "options.0.sign_saml_request".
+type AttributePaths struct {
+	Schema    map[string]*schema.Schema
+	Overrides map[string]string
+}
+
+// Resolve returns the path of the attribute the property maps onto,
+// or nil when the property doesn't map to any attribute in the schema.
+func (p AttributePaths) Resolve(property string) cty.Path {
+	segments := strings.Split(strings.NewReplacer("[", ".", "]", "").Replace(property), ".")
+
+	var path cty.Path
+	current := p.Schema
+
+	for index := len(segments); index > 0; index-- {
+		override, ok := p.Overrides[strings.Join(segments[:index], ".")]
+		if !ok {
+			continue
+		}
+
+		var inBlock bool
+		path, current, inBlock = resolveAttributePath(p.Schema, strings.Split(override, "."), true)
+		if path == nil {
+			return nil
+		}
+
+		segments = segments[index:]
+		if inBlock && len(segments) > 0 {
+			path = path.IndexInt(0)
+		}
+
+		break
+	}
+
+	resolved, _, _ := resolveAttributePath(current, segments, false)
+
+	return append(path, resolved...)
+}
+
+// resolveAttributePath walks the schema along the segments, returning the path
+// of the deepest attribute found and the schema of its block, if it's one. The
+// path ends at the block when the last segment is a block, rather than its only
+// element. Segments that aren't attributes of the schema are skipped unless
+// strict, as the payload nests some properties in objects the schema flattens.
+func resolveAttributePath(
+	resourceSchema map[string]*schema.Schema,
+	segments []string,
+	strict bool,
+) (cty.Path, map[string]*schema.Schema, bool) {
+	var path cty.Path
+
+	for index := 0; index < len(segments) && resourceSchema != nil; index++ {
+		name := segments[index]
+		if !strict {
+			name = toSnakeCase(name)
+		}
+
+		attribute, ok := resourceSchema[name]
+		if !ok {
+			if strict {
+				return nil, nil, false
+			}
+			continue
+		}
+
+		path = path.GetAttr(name)
+		resourceSchema = nil
+
+		switch attribute.Type {
+		case schema.TypeList:
+			block, isBlock := attribute.Elem.(*schema.Resource)
+
+			switch position, err := strconv.Atoi(segmentAt(segments, index+1)); {
+			case err == nil:
+				path = path.IndexInt(position)
+				index++
+			case index+1 == len(segments):
+				if isBlock && attribute.MaxItems == 1 {
+					return path, block.SchemaMap(), true
+				}
+				return path, nil, false
+			case attribute.MaxItems == 1:
+				path = path.IndexInt(0)
+			default:
+				return path, nil, false
+			}
+
+			if isBlock {
+				resourceSchema = block.SchemaMap()
+			}
+		case schema.TypeMap:
+			if index+1 < len(segments) {
+				path = path.IndexString(strings.Join(segments[index+1:], "."))
+			}
+			return path, nil, false
+		case schema.TypeSet:
+			return path, nil, false
+		}
+	}
+
+	return path, resourceSchema, false
+}
+
+func segmentAt(segments []string, index int) string {
+	if index < len(segments) {
+		return segments[index]
+	}
+	return ""
+}
+
+var snakeCaseBoundary = regexp.MustCompile(`([a-z0-9])([A-Z])`)
+
+func toSnakeCase(name string) string {
+	return strings.ToLower(snakeCaseBoundary.ReplaceAllString(name, "${1}_${2}"))
+}
+
+// APIErrorDiagnostics converts an error returned by the Management API on a
+// create or update into diagnostics. When it's a validation error naming the
+// properties of the payload at fault, each diagnostic points at the attribute
+// the property maps onto, so Terraform can show the offending configuration.
+// Any other error is returned as is.
+func APIErrorDiagnostics(err error, paths AttributePaths) diag.Diagnostics {
+	if err == nil {
+		return nil
+	}
+
+	body, ok := ParseAPIError(err)
+	if !ok || body.StatusCode != http.StatusBadRequest {
+		return diag.FromErr(err)
+	}
+
+	properties := body.Properties()
+	if len(properties) == 0 {
+		return diag.FromErr(err)
+	}
+
+	diagnostics := make(diag.Diagnostics, 0, len(properties))
+	for _, property := range properties {
+		detail := fmt.Sprintf("The Auth0 Management API rejected the %q property of the payload.", property)
+		if body.ErrorCode != "" {
+			detail = fmt.Sprintf(
+				"The Auth0 Management API rejected the %q property of the payload with the %q error code.",
+				property,
+				body.ErrorCode,
+			)
+		}
+
+		diagnostics = append(diagnostics, diag.Diagnostic{
+			Severity:      diag.Error,
+			Summary:       err.Error(),
+			Detail:        detail,
+			AttributePath: paths.Resolve(property),
+		})
+	}
+
+	return diagnostics
+}
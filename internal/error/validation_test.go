@@ -0,0 +1,236 @@
+package error
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/auth0/go-auth0/management"
+	managementv3 "github.com/auth0/go-auth0/v3/management"
+	"github.com/auth0/go-auth0/v3/management/core"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testAttributePaths = AttributePaths{
+	Schema: map[string]*schema.Schema{
+		"name": {Type: schema.TypeString},
+		"callbacks": {
+			Type: schema.TypeList,
+			Elem: &schema.Schema{Type: schema.TypeString},
+		},
+		"metadata": {Type: schema.TypeMap},
+		"options": {
+			Type:     schema.TypeList,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"sign_in_endpoint":  {Type: schema.TypeString},
+					"sign_saml_request": {Type: schema.TypeBool},
+					"scopes":            {Type: schema.TypeSet, Elem: &schema.Schema{Type: schema.TypeString}},
+					"idp_initiated": {
+						Type:     schema.TypeList,
+						MaxItems: 1,
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								"client_id": {Type: schema.TypeString},
+							},
+						},
+					},
+				},
+			},
+		},
+	},
+	Overrides: map[string]string{
+		"options.idpinitiated":    "options.0.idp_initiated",
+		"options.signSAMLRequest": "options.0.sign_saml_request",
+	},
+}
+
+// newV1APIError returns the error the v1 SDK returns when
+// the Management API responds with the given status and body.
+func newV1APIError(t *testing.T, statusCode int, body string) error {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(statusCode)
+		_, _ = w.Write([]byte(body))
+	}))
+	t.Cleanup(server.Close)
+
+	api, err := management.New(strings.TrimPrefix(server.URL, "http://"),
+		management.WithStaticToken("test-token"), management.WithInsecure(), management.WithNoRetries())
+	require.NoError(t, err)
+
+	err = api.Client.Create(context.Background(), &management.Client{})
+	require.Error(t, err)
+
+	return err
+}
+
+func TestParseAPIError(t *testing.T) {
+	t.Run("it parses a v1 SDK error", func(t *testing.T) {
+		err := newV1APIError(t, http.StatusBadRequest,
+			`{"statusCode":400,"error":"Bad Request","message":"Payload validation error: 'Invalid value' (property: options.signInEndpoint)","errorCode":"invalid_body"}`)
+
+		body, ok := ParseAPIError(fmt.Errorf("failed to create: %w", err))
+		require.True(t, ok)
+		assert.Equal(t, http.StatusBadRequest, body.StatusCode)
+		assert.Equal(t, "Bad Request", body.Error)
+		assert.Equal(t, "Payload validation error: 'Invalid value' (property: options.signInEndpoint)", body.Message)
+		assert.Equal(t, []string{"options.signInEndpoint"}, body.Properties())
+	})
+
+	t.Run("it parses a v3 SDK error with a body", func(t *testing.T) {
+		err := &managementv3.BadRequestError{
+			APIError: core.NewAPIError(http.StatusBadRequest, nil, errors.New("bad request")),
+			Body: map[string]interface{}{
+				"statusCode": 400,
+				"error":      "Bad Request",
+				"message":    "Payload validation error: 'Expected type string but found type integer' on property name.",
+				"errorCode":  "invalid_body",
+			},
+		}
+
+		body, ok := ParseAPIError(err)
+		require.True(t, ok)
+		assert.Equal(t, http.StatusBadRequest, body.StatusCode)
+		assert.Equal(t, "invalid_body", body.ErrorCode)
+		assert.Equal(t, []string{"name"}, body.Properties())
+	})
+
+	t.Run("it parses a bare v3 SDK error holding the raw body", func(t *testing.T) {
+		err := core.NewAPIError(http.StatusBadRequest, nil,
+			errors.New(`{"statusCode":400,"message":"Invalid value (property: callbacks[1])","errorCode":"invalid_uri"}`))
+
+		body, ok := ParseAPIError(err)
+		require.True(t, ok)
+		assert.Equal(t, "invalid_uri", body.ErrorCode)
+		assert.Equal(t, []string{"callbacks[1]"}, body.Properties())
+	})
+
+	t.Run("it ignores errors that don't come from the Management API", func(t *testing.T) {
+		_, ok := ParseAPIError(errors.New("(property: name)"))
+		assert.False(t, ok)
+	})
+}
+
+func TestAttributePaths_Resolve(t *testing.T) {
+	testCases := []struct {
+		property string
+		expected cty.Path
+	}{
+		{
+			property: "name",
+			expected: cty.GetAttrPath("name"),
+		},
+		{
+			property: "options.signInEndpoint",
+			expected: cty.GetAttrPath("options").IndexInt(0).GetAttr("sign_in_endpoint"),
+		},
+		{
+			property: "options.saml.signInEndpoint",
+			expected: cty.GetAttrPath("options").IndexInt(0).GetAttr("sign_in_endpoint"),
+		},
+		{
+			property: "options",
+			expected: cty.GetAttrPath("options"),
+		},
+		{
+			property: "options.signSAMLRequest",
+			expected: cty.GetAttrPath("options").IndexInt(0).GetAttr("sign_saml_request"),
+		},
+		{
+			property: "options.idpinitiated",
+			expected: cty.GetAttrPath("options").IndexInt(0).GetAttr("idp_initiated"),
+		},
+		{
+			property: "options.idpinitiated.client_id",
+			expected: cty.GetAttrPath("options").IndexInt(0).GetAttr("idp_initiated").IndexInt(0).GetAttr("client_id"),
+		},
+		{
+			property: "options.scopes.0",
+			expected: cty.GetAttrPath("options").IndexInt(0).GetAttr("scopes"),
+		},
+		{
+			property: "callbacks[1]",
+			expected: cty.GetAttrPath("callbacks").IndexInt(1),
+		},
+		{
+			property: "callbacks",
+			expected: cty.GetAttrPath("callbacks"),
+		},
+		{
+			property: "metadata.some.key",
+			expected: cty.GetAttrPath("metadata").IndexString("some.key"),
+		},
+		{
+			property: "unknown",
+			expected: nil,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.property, func(t *testing.T) {
+			assert.Equal(t, testCase.expected, testAttributePaths.Resolve(testCase.property))
+		})
+	}
+}
+
+func TestAPIErrorDiagnostics(t *testing.T) {
+	t.Run("it returns nil without an error", func(t *testing.T) {
+		assert.Nil(t, APIErrorDiagnostics(nil, testAttributePaths))
+	})
+
+	t.Run("it points validation errors at the attributes", func(t *testing.T) {
+		err := newV1APIError(t, http.StatusBadRequest,
+			`{"statusCode":400,"error":"Bad Request","message":"Payload validation error: 'Invalid value \"foo\" (property: options.saml.signInEndpoint)' and 'Invalid URI' (property: callbacks[0])"}`)
+
+		diagnostics := APIErrorDiagnostics(err, testAttributePaths)
+		require.Len(t, diagnostics, 2)
+
+		assert.Equal(t, diag.Error, diagnostics[0].Severity)
+		assert.Equal(t, err.Error(), diagnostics[0].Summary)
+		assert.Equal(t, `The Auth0 Management API rejected the "options.saml.signInEndpoint" property of the payload.`, diagnostics[0].Detail)
+		assert.Equal(t, cty.GetAttrPath("options").IndexInt(0).GetAttr("sign_in_endpoint"), diagnostics[0].AttributePath)
+
+		assert.Equal(t, cty.GetAttrPath("callbacks").IndexInt(0), diagnostics[1].AttributePath)
+	})
+
+	t.Run("it includes the error code in the detail", func(t *testing.T) {
+		err := &managementv3.BadRequestError{
+			APIError: core.NewAPIError(http.StatusBadRequest, nil, errors.New("bad request")),
+			Body: map[string]interface{}{
+				"message":   "Payload validation error: 'String is too long' on property name.",
+				"errorCode": "invalid_body",
+			},
+		}
+
+		diagnostics := APIErrorDiagnostics(err, testAttributePaths)
+		require.Len(t, diagnostics, 1)
+		assert.Equal(
+			t,
+			`The Auth0 Management API rejected the "name" property of the payload with the "invalid_body" error code.`,
+			diagnostics[0].Detail,
+		)
+		assert.Equal(t, cty.GetAttrPath("name"), diagnostics[0].AttributePath)
+	})
+
+	t.Run("it returns any other error as is", func(t *testing.T) {
+		for _, err := range []error{
+			errors.New("something went wrong"),
+			newV1APIError(t, http.StatusBadRequest, `{"statusCode":400,"error":"Bad Request","message":"Invalid request"}`),
+			newV1APIError(t, http.StatusConflict, `{"statusCode":409,"error":"Conflict","message":"Already exists (property: name)"}`),
+		} {
+			assert.Equal(t, diag.FromErr(err), APIErrorDiagnostics(err, testAttributePaths))
+		}
+	})
+}
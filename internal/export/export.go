@@ -0,0 +1,326 @@
+// Package export generates the Terraform configuration of an existing tenant.
+package export
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/zclconf/go-cty/cty"
+)
+
+// defaultSkippedResourceTypes holds the resource types only exported when
+// explicitly requested, as tenants can hold a huge number of them.
+var defaultSkippedResourceTypes = map[string]bool{
+	"auth0_user": true,
+}
+
+// Options configures what gets exported and where.
+type Options struct {
+	// OutputDir is the directory the configuration files are written to.
+	OutputDir string
+
+	// ResourceTypes holds the resource types to export, e.g. "auth0_client".
+	// All resource types with a list resource but auth0_user are exported when empty.
+	ResourceTypes []string
+}
+
+// Command runs the export command with the given arguments,
+// reading the provider configuration from the environment.
+func Command(ctx context.Context, providerServer tfprotov5.ProviderServer, args []string, output io.Writer) error {
+	flags := flag.NewFlagSet("export", flag.ContinueOnError)
+	flags.SetOutput(output)
+	flags.Usage = func() {
+		_, _ = fmt.Fprintf(output, "Usage: terraform-provider-auth0 export [options]\n\n"+
+			"Generates the import blocks and configuration of the resources of the tenant\n"+
+			"the provider is configured for through the AUTH0_* environment variables.\n\n")
+		flags.PrintDefaults()
+	}
+
+	outputDir := flags.String("out", "auth0-export", "The directory the configuration files are written to.")
+	resourceTypes := flags.String("resources", "", "A comma separated list of the resource types to export, "+
+		"e.g. auth0_client,auth0_connection. Defaults to all supported resource types but auth0_user.")
+
+	if err := flags.Parse(args); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return nil
+		}
+		return err
+	}
+
+	options := Options{OutputDir: *outputDir}
+	if *resourceTypes != "" {
+		for _, resourceType := range strings.Split(*resourceTypes, ",") {
+			options.ResourceTypes = append(options.ResourceTypes, strings.TrimSpace(resourceType))
+		}
+	}
+
+	files, err := Run(ctx, providerServer, options)
+	if err != nil {
+		return err
+	}
+
+	for _, file := range files {
+		_, _ = fmt.Fprintf(output, "Wrote %s\n", file)
+	}
+
+	return nil
+}
+
+// Run exports the resources of the tenant into the output directory, returning the written files.
+// The resources are found through their list resources and read by the provider itself, so the
+// configuration matches the schemas of the provider. Sensitive values are replaced by variables.
+func Run(ctx context.Context, providerServer tfprotov5.ProviderServer, options Options) ([]string, error) {
+	listServer, ok := providerServer.(tfprotov5.ProviderServerWithListResource)
+	if !ok {
+		return nil, fmt.Errorf("the provider server doesn't support list resources")
+	}
+
+	schemas, err := providerServer.GetProviderSchema(ctx, &tfprotov5.GetProviderSchemaRequest{})
+	if err != nil {
+		return nil, err
+	}
+	if err := diagnosticsError(schemas.Diagnostics); err != nil {
+		return nil, err
+	}
+
+	resourceTypes, err := exportedResourceTypes(schemas, options.ResourceTypes)
+	if err != nil {
+		return nil, err
+	}
+
+	providerConfig, err := nullConfig(schemas.Provider.Block)
+	if err != nil {
+		return nil, err
+	}
+
+	configured, err := providerServer.ConfigureProvider(ctx, &tfprotov5.ConfigureProviderRequest{Config: providerConfig})
+	if err != nil {
+		return nil, err
+	}
+	if err := diagnosticsError(configured.Diagnostics); err != nil {
+		return nil, fmt.Errorf("failed to configure the provider: %w", err)
+	}
+
+	if err := os.MkdirAll(options.OutputDir, 0o755); err != nil {
+		return nil, err
+	}
+
+	writer := &resourceWriter{}
+	var files []string
+
+	for _, resourceType := range resourceTypes {
+		file := hclwrite.NewEmptyFile()
+		if err := exportResources(ctx, listServer, schemas, resourceType, writer, file.Body()); err != nil {
+			return files, fmt.Errorf("failed to export the %s resources: %w", resourceType, err)
+		}
+
+		path := filepath.Join(options.OutputDir, resourceType+".tf")
+		if err := os.WriteFile(path, file.Bytes(), 0o600); err != nil {
+			return files, err
+		}
+		files = append(files, path)
+	}
+
+	if len(writer.variables) > 0 {
+		path := filepath.Join(options.OutputDir, "variables.tf")
+		if err := os.WriteFile(path, variablesFile(writer.variables), 0o600); err != nil {
+			return files, err
+		}
+		files = append(files, path)
+	}
+
+	return files, nil
+}
+
+// exportedResourceTypes checks the requested resource types, defaulting
+// to all the resource types with a list resource.
+func exportedResourceTypes(schemas *tfprotov5.GetProviderSchemaResponse, requested []string) ([]string, error) {
+	for _, resourceType := range requested {
+		if _, ok := schemas.ListResourceSchemas[resourceType]; !ok {
+			return nil, fmt.Errorf("the %q resource type can't be exported", resourceType)
+		}
+	}
+
+	if len(requested) > 0 {
+		return requested, nil
+	}
+
+	var resourceTypes []string
+	for resourceType := range schemas.ListResourceSchemas {
+		if !defaultSkippedResourceTypes[resourceType] {
+			resourceTypes = append(resourceTypes, resourceType)
+		}
+	}
+	sort.Strings(resourceTypes)
+
+	return resourceTypes, nil
+}
+
+// exportResources lists all the resources of the given type, appending
+// their import and resource blocks to the body.
+func exportResources(
+	ctx context.Context,
+	listServer tfprotov5.ProviderServerWithListResource,
+	schemas *tfprotov5.GetProviderSchemaResponse,
+	resourceType string,
+	writer *resourceWriter,
+	body *hclwrite.Body,
+) error {
+	resourceSchema, ok := schemas.ResourceSchemas[resourceType]
+	if !ok {
+		return fmt.Errorf("missing the schema of the resource")
+	}
+
+	listConfig, err := nullConfig(schemas.ListResourceSchemas[resourceType].Block)
+	if err != nil {
+		return err
+	}
+
+	stream, err := listServer.ListResource(ctx, &tfprotov5.ListResourceRequest{
+		TypeName:        resourceType,
+		Config:          listConfig,
+		IncludeResource: true,
+	})
+	if err != nil {
+		return err
+	}
+
+	names := resourceNames{}
+	resourceValueType := resourceSchema.ValueType()
+
+	for result := range stream.Results {
+		if err := diagnosticsError(result.Diagnostics); err != nil {
+			return err
+		}
+
+		if result.Resource == nil {
+			continue
+		}
+
+		state, err := result.Resource.Unmarshal(resourceValueType)
+		if err != nil {
+			return err
+		}
+
+		id, err := stateID(state)
+		if err != nil {
+			return err
+		}
+
+		name := names.next(resourceType, result.DisplayName)
+
+		if len(body.Blocks()) > 0 {
+			body.AppendNewline()
+		}
+
+		importBlock := body.AppendNewBlock("import", nil).Body()
+		importBlock.SetAttributeRaw("to", hclwrite.TokensForIdentifier(resourceType+"."+name))
+		importBlock.SetAttributeValue("id", cty.StringVal(id))
+		body.AppendNewline()
+
+		if err := writer.writeResource(body, resourceType, name, resourceSchema, state); err != nil {
+			return fmt.Errorf("failed to write %q: %w", id, err)
+		}
+	}
+
+	return nil
+}
+
+func stateID(state tftypes.Value) (string, error) {
+	var attributes map[string]tftypes.Value
+	if err := state.As(&attributes); err != nil {
+		return "", err
+	}
+
+	var id string
+	if err := attributes["id"].As(&id); err != nil {
+		return "", err
+	}
+
+	return id, nil
+}
+
+// nullConfig returns a configuration of the block where nothing is set.
+func nullConfig(block *tfprotov5.SchemaBlock) (*tfprotov5.DynamicValue, error) {
+	valueType := block.ValueType()
+	value := tftypes.NewValue(valueType, nullAttributes(block))
+
+	config, err := tfprotov5.NewDynamicValue(valueType, value)
+	if err != nil {
+		return nil, err
+	}
+
+	return &config, nil
+}
+
+func nullAttributes(block *tfprotov5.SchemaBlock) map[string]tftypes.Value {
+	attributes := make(map[string]tftypes.Value)
+
+	for _, attribute := range block.Attributes {
+		attributes[attribute.Name] = tftypes.NewValue(attribute.ValueType(), nil)
+	}
+
+	for _, blockType := range block.BlockTypes {
+		valueType := blockType.ValueType()
+
+		switch blockType.Nesting {
+		case tfprotov5.SchemaNestedBlockNestingModeList, tfprotov5.SchemaNestedBlockNestingModeSet:
+			attributes[blockType.TypeName] = tftypes.NewValue(valueType, []tftypes.Value{})
+		case tfprotov5.SchemaNestedBlockNestingModeMap:
+			attributes[blockType.TypeName] = tftypes.NewValue(valueType, map[string]tftypes.Value{})
+		default:
+			attributes[blockType.TypeName] = tftypes.NewValue(valueType, nil)
+		}
+	}
+
+	return attributes
+}
+
+// variablesFile returns the declarations of the variables replacing sensitive values.
+func variablesFile(variables []variable) []byte {
+	file := hclwrite.NewEmptyFile()
+
+	for index, variable := range variables {
+		if index > 0 {
+			file.Body().AppendNewline()
+		}
+
+		body := file.Body().AppendNewBlock("variable", []string{variable.Name}).Body()
+		body.SetAttributeRaw("type", hclwrite.TokensForIdentifier(variable.Type))
+		body.SetAttributeValue("sensitive", cty.True)
+	}
+
+	return file.Bytes()
+}
+
+func diagnosticsError(diagnostics []*tfprotov5.Diagnostic) error {
+	var messages []string
+
+	for _, diagnostic := range diagnostics {
+		if diagnostic.Severity != tfprotov5.DiagnosticSeverityError {
+			continue
+		}
+
+		message := diagnostic.Summary
+		if diagnostic.Detail != "" {
+			message += ": " + diagnostic.Detail
+		}
+		messages = append(messages, message)
+	}
+
+	if len(messages) == 0 {
+		return nil
+	}
+
+	return fmt.Errorf("%s", strings.Join(messages, "; "))
+}
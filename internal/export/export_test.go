@@ -0,0 +1,277 @@
+package export
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"sort"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	fwdiag "github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	fwprovider "github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-mux/tf5muxserver"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	internalSchema "github.com/auth0/terraform-provider-auth0/internal/schema"
+)
+
+// testTenant is the fake API of the test provider, holding the things by ID.
+type testTenant map[string]map[string]interface{}
+
+var testThings = testTenant{
+	"thing-1": {
+		"name":     "My Thing",
+		"secret":   "s3cr3t",
+		"status":   "active",
+		"metadata": map[string]interface{}{"team": "iam"},
+		"options": []interface{}{
+			map[string]interface{}{"client_secret": "0pt10n", "enabled": false},
+		},
+	},
+	"thing-2": {
+		"name":     "My Thing",
+		"secret":   "",
+		"status":   "disabled",
+		"metadata": map[string]interface{}{},
+		"options":  []interface{}{},
+	},
+	"thing-3": {
+		"name":     "2nd thing!",
+		"secret":   "",
+		"status":   "active",
+		"metadata": map[string]interface{}{},
+		"options":  []interface{}{},
+	},
+}
+
+func newTestThingResource() *schema.Resource {
+	return internalSchema.WithIDIdentity(&schema.Resource{
+		ReadContext: func(_ context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			thing, ok := meta.(testTenant)[data.Id()]
+			if !ok {
+				data.SetId("")
+				return nil
+			}
+
+			for key, value := range thing {
+				if err := data.Set(key, value); err != nil {
+					return diag.FromErr(err)
+				}
+			}
+
+			return nil
+		},
+		CreateContext: schema.NoopContext,
+		UpdateContext: schema.NoopContext,
+		DeleteContext: schema.NoopContext,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"secret": {
+				Type:      schema.TypeString,
+				Optional:  true,
+				Sensitive: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"metadata": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"options": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"client_secret": {
+							Type:      schema.TypeString,
+							Optional:  true,
+							Sensitive: true,
+						},
+						"enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+					},
+				},
+			},
+		},
+	})
+}
+
+type testFrameworkProvider struct {
+	sdkProvider *schema.Provider
+}
+
+func (p *testFrameworkProvider) Metadata(_ context.Context, _ fwprovider.MetadataRequest, resp *fwprovider.MetadataResponse) {
+	resp.TypeName = "auth0"
+}
+
+func (p *testFrameworkProvider) Schema(context.Context, fwprovider.SchemaRequest, *fwprovider.SchemaResponse) {
+}
+
+func (p *testFrameworkProvider) Configure(_ context.Context, _ fwprovider.ConfigureRequest, resp *fwprovider.ConfigureResponse) {
+	resp.ListResourceData = p.sdkProvider.Meta()
+}
+
+func (p *testFrameworkProvider) Resources(context.Context) []func() resource.Resource {
+	return nil
+}
+
+func (p *testFrameworkProvider) DataSources(context.Context) []func() datasource.DataSource {
+	return nil
+}
+
+func (p *testFrameworkProvider) ListResources(context.Context) []func() list.ListResource {
+	return []func() list.ListResource{
+		func() list.ListResource {
+			return internalSchema.NewListResource(internalSchema.ListResourceConfig{
+				TypeName: "_thing",
+				Resource: newTestThingResource,
+				Schema: listschema.Schema{
+					Attributes: map[string]listschema.Attribute{
+						"name_filter": listschema.StringAttribute{Optional: true},
+					},
+				},
+				List: func(
+					_ context.Context,
+					_ tfsdk.Config,
+					meta interface{},
+					yield func(internalSchema.ListItem) bool,
+				) fwdiag.Diagnostics {
+					var ids []string
+					for id := range meta.(testTenant) {
+						ids = append(ids, id)
+					}
+					sort.Strings(ids)
+
+					for _, id := range ids {
+						if !yield(internalSchema.ListItem{ID: id, DisplayName: meta.(testTenant)[id]["name"].(string)}) {
+							break
+						}
+					}
+
+					return nil
+				},
+			})
+		},
+	}
+}
+
+func newTestProviderServer(t *testing.T) tfprotov5.ProviderServer {
+	t.Helper()
+
+	sdkProvider := &schema.Provider{
+		ResourcesMap: map[string]*schema.Resource{
+			"auth0_thing": newTestThingResource(),
+		},
+		ConfigureContextFunc: func(context.Context, *schema.ResourceData) (interface{}, diag.Diagnostics) {
+			return testThings, nil
+		},
+	}
+	require.NoError(t, sdkProvider.InternalValidate())
+
+	muxServer, err := tf5muxserver.NewMuxServer(
+		context.Background(),
+		sdkProvider.GRPCProvider,
+		providerserver.NewProtocol5(&testFrameworkProvider{sdkProvider: sdkProvider}),
+	)
+	require.NoError(t, err)
+
+	return muxServer.ProviderServer()
+}
+
+const expectedThings = `import {
+  to = auth0_thing.my_thing
+  id = "thing-1"
+}
+
+resource "auth0_thing" "my_thing" {
+  metadata = {
+    team = "iam"
+  }
+  name   = "My Thing"
+  secret = var.thing_my_thing_secret
+  options {
+    client_secret = var.thing_my_thing_options_client_secret
+    enabled       = false
+  }
+}
+
+import {
+  to = auth0_thing.my_thing_2
+  id = "thing-2"
+}
+
+resource "auth0_thing" "my_thing_2" {
+  name = "My Thing"
+}
+
+import {
+  to = auth0_thing.thing_2nd_thing
+  id = "thing-3"
+}
+
+resource "auth0_thing" "thing_2nd_thing" {
+  name = "2nd thing!"
+}
+`
+
+const expectedVariables = `variable "thing_my_thing_secret" {
+  type      = string
+  sensitive = true
+}
+
+variable "thing_my_thing_options_client_secret" {
+  type      = string
+  sensitive = true
+}
+`
+
+func TestCommand(t *testing.T) {
+	outputDir := t.TempDir()
+
+	var output bytes.Buffer
+	err := Command(context.Background(), newTestProviderServer(t), []string{"-out", outputDir}, &output)
+	require.NoError(t, err)
+
+	thingsFile := filepath.Join(outputDir, "auth0_thing.tf")
+	variablesFile := filepath.Join(outputDir, "variables.tf")
+	assert.Equal(t, "Wrote "+thingsFile+"\nWrote "+variablesFile+"\n", output.String())
+
+	things, err := os.ReadFile(thingsFile)
+	require.NoError(t, err)
+	assert.Equal(t, expectedThings, string(things))
+
+	variables, err := os.ReadFile(variablesFile)
+	require.NoError(t, err)
+	assert.Equal(t, expectedVariables, string(variables))
+}
+
+func TestRun_UnknownResourceType(t *testing.T) {
+	_, err := Run(context.Background(), newTestProviderServer(t), Options{
+		OutputDir:     t.TempDir(),
+		ResourceTypes: []string{"auth0_tenant"},
+	})
+	assert.EqualError(t, err, `the "auth0_tenant" resource type can't be exported`)
+}
@@ -0,0 +1,268 @@
+package export
+
+import (
+	"fmt"
+	"math/big"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/zclconf/go-cty/cty"
+)
+
+// skippedBlocks holds the nested blocks that never hold the configuration of a resource.
+var skippedBlocks = map[string]bool{
+	"timeouts": true,
+}
+
+// variable is an input variable replacing a sensitive value of an exported resource.
+type variable struct {
+	Name string
+	Type string
+}
+
+// resourceWriter writes resources as HCL, replacing their sensitive values with variables.
+type resourceWriter struct {
+	variables []variable
+}
+
+// writeResource appends the resource block of the given state to the body.
+func (w *resourceWriter) writeResource(
+	body *hclwrite.Body,
+	resourceType, name string,
+	schema *tfprotov5.Schema,
+	state tftypes.Value,
+) error {
+	resourceBlock := body.AppendNewBlock("resource", []string{resourceType, name})
+
+	variablePrefix := strings.TrimPrefix(resourceType, "auth0_") + "_" + name
+
+	return w.writeBlock(resourceBlock.Body(), variablePrefix, schema.Block, state)
+}
+
+func (w *resourceWriter) writeBlock(
+	body *hclwrite.Body,
+	variablePrefix string,
+	block *tfprotov5.SchemaBlock,
+	value tftypes.Value,
+) error {
+	var values map[string]tftypes.Value
+	if err := value.As(&values); err != nil {
+		return err
+	}
+
+	attributes := make([]*tfprotov5.SchemaAttribute, 0, len(block.Attributes))
+	for _, attribute := range block.Attributes {
+		if !isConfigurable(attribute) || attribute.Name == "id" {
+			continue
+		}
+		attributes = append(attributes, attribute)
+	}
+	sort.Slice(attributes, func(i, j int) bool { return attributes[i].Name < attributes[j].Name })
+
+	for _, attribute := range attributes {
+		attributeValue, ok := values[attribute.Name]
+		if !ok || isEmpty(attributeValue) {
+			continue
+		}
+
+		if attribute.Sensitive {
+			variableName := variablePrefix + "_" + attribute.Name
+			w.variables = append(w.variables, variable{
+				Name: variableName,
+				Type: variableType(attributeValue.Type()),
+			})
+			body.SetAttributeTraversal(attribute.Name, hcl.Traversal{
+				hcl.TraverseRoot{Name: "var"},
+				hcl.TraverseAttr{Name: variableName},
+			})
+			continue
+		}
+
+		converted, err := ctyValue(attributeValue)
+		if err != nil {
+			return fmt.Errorf("failed to convert %q: %w", attribute.Name, err)
+		}
+		body.SetAttributeValue(attribute.Name, converted)
+	}
+
+	blockTypes := make([]*tfprotov5.SchemaNestedBlock, 0, len(block.BlockTypes))
+	for _, blockType := range block.BlockTypes {
+		if skippedBlocks[blockType.TypeName] || blockType.Block.Deprecated {
+			continue
+		}
+		blockTypes = append(blockTypes, blockType)
+	}
+	sort.Slice(blockTypes, func(i, j int) bool { return blockTypes[i].TypeName < blockTypes[j].TypeName })
+
+	for _, blockType := range blockTypes {
+		blockValue, ok := values[blockType.TypeName]
+		if !ok || blockValue.IsNull() || !blockValue.IsKnown() {
+			continue
+		}
+
+		var elements []tftypes.Value
+		switch blockType.Nesting {
+		case tfprotov5.SchemaNestedBlockNestingModeList, tfprotov5.SchemaNestedBlockNestingModeSet:
+			if err := blockValue.As(&elements); err != nil {
+				return err
+			}
+		case tfprotov5.SchemaNestedBlockNestingModeSingle, tfprotov5.SchemaNestedBlockNestingModeGroup:
+			elements = []tftypes.Value{blockValue}
+		default:
+			return fmt.Errorf("unsupported nesting mode %s of block %q", blockType.Nesting, blockType.TypeName)
+		}
+
+		for index, element := range elements {
+			elementPrefix := variablePrefix + "_" + blockType.TypeName
+			if len(elements) > 1 {
+				elementPrefix += fmt.Sprintf("_%d", index)
+			}
+
+			nestedBlock := body.AppendNewBlock(blockType.TypeName, nil)
+			if err := w.writeBlock(nestedBlock.Body(), elementPrefix, blockType.Block, element); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// isConfigurable tells whether the attribute can be set in the configuration.
+func isConfigurable(attribute *tfprotov5.SchemaAttribute) bool {
+	return (attribute.Required || attribute.Optional) && !attribute.Deprecated
+}
+
+// isEmpty tells whether the value can be left out of the configuration,
+// as the SDKv2 doesn't tell apart null and zero values.
+func isEmpty(value tftypes.Value) bool {
+	if value.IsNull() || !value.IsKnown() {
+		return true
+	}
+
+	switch {
+	case value.Type().Is(tftypes.String):
+		var s string
+		return value.As(&s) == nil && s == ""
+	case value.Type().Is(tftypes.List{}), value.Type().Is(tftypes.Set{}), value.Type().Is(tftypes.Tuple{}):
+		var elements []tftypes.Value
+		return value.As(&elements) == nil && len(elements) == 0
+	case value.Type().Is(tftypes.Map{}):
+		var elements map[string]tftypes.Value
+		return value.As(&elements) == nil && len(elements) == 0
+	}
+
+	return false
+}
+
+// variableType returns the type constraint of a variable holding a value of the given type.
+func variableType(valueType tftypes.Type) string {
+	switch {
+	case valueType.Is(tftypes.String):
+		return "string"
+	case valueType.Is(tftypes.Number):
+		return "number"
+	case valueType.Is(tftypes.Bool):
+		return "bool"
+	case valueType.Is(tftypes.Map{}):
+		return "map(" + variableType(valueType.(tftypes.Map).ElementType) + ")"
+	case valueType.Is(tftypes.List{}):
+		return "list(" + variableType(valueType.(tftypes.List).ElementType) + ")"
+	case valueType.Is(tftypes.Set{}):
+		return "set(" + variableType(valueType.(tftypes.Set).ElementType) + ")"
+	}
+
+	return "any"
+}
+
+// ctyValue converts the value into a cty value, which hclwrite knows how to write.
+// Collections are converted into tuples and objects, so their elements may differ in type.
+func ctyValue(value tftypes.Value) (cty.Value, error) {
+	if value.IsNull() || !value.IsKnown() {
+		return cty.NullVal(cty.DynamicPseudoType), nil
+	}
+
+	valueType := value.Type()
+	switch {
+	case valueType.Is(tftypes.String):
+		var s string
+		if err := value.As(&s); err != nil {
+			return cty.NilVal, err
+		}
+		return cty.StringVal(s), nil
+	case valueType.Is(tftypes.Number):
+		n := new(big.Float)
+		if err := value.As(&n); err != nil {
+			return cty.NilVal, err
+		}
+		return cty.NumberVal(n), nil
+	case valueType.Is(tftypes.Bool):
+		var b bool
+		if err := value.As(&b); err != nil {
+			return cty.NilVal, err
+		}
+		return cty.BoolVal(b), nil
+	case valueType.Is(tftypes.List{}), valueType.Is(tftypes.Set{}), valueType.Is(tftypes.Tuple{}):
+		var elements []tftypes.Value
+		if err := value.As(&elements); err != nil {
+			return cty.NilVal, err
+		}
+		if len(elements) == 0 {
+			return cty.EmptyTupleVal, nil
+		}
+		converted := make([]cty.Value, 0, len(elements))
+		for _, element := range elements {
+			convertedElement, err := ctyValue(element)
+			if err != nil {
+				return cty.NilVal, err
+			}
+			converted = append(converted, convertedElement)
+		}
+		return cty.TupleVal(converted), nil
+	case valueType.Is(tftypes.Map{}), valueType.Is(tftypes.Object{}):
+		var elements map[string]tftypes.Value
+		if err := value.As(&elements); err != nil {
+			return cty.NilVal, err
+		}
+		if len(elements) == 0 {
+			return cty.EmptyObjectVal, nil
+		}
+		converted := make(map[string]cty.Value, len(elements))
+		for key, element := range elements {
+			convertedElement, err := ctyValue(element)
+			if err != nil {
+				return cty.NilVal, err
+			}
+			converted[key] = convertedElement
+		}
+		return cty.ObjectVal(converted), nil
+	}
+
+	return cty.NilVal, fmt.Errorf("unsupported type %s", valueType)
+}
+
+var nonIdentifierCharacters = regexp.MustCompile(`[^a-z0-9_]+`)
+
+// resourceNames hands out unique resource names derived from the display names of the resources.
+type resourceNames map[string]bool
+
+// next returns a valid and unique name for a resource of the given type.
+func (n resourceNames) next(resourceType, displayName string) string {
+	base := strings.Trim(nonIdentifierCharacters.ReplaceAllString(strings.ToLower(displayName), "_"), "_")
+	if base == "" || (base[0] >= '0' && base[0] <= '9') {
+		base = strings.TrimSuffix(strings.TrimPrefix(resourceType, "auth0_")+"_"+base, "_")
+	}
+
+	name := base
+	for i := 2; n[resourceType+"."+name]; i++ {
+		name = fmt.Sprintf("%s_%d", base, i)
+	}
+	n[resourceType+"."+name] = true
+
+	return name
+}
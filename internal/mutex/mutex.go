@@ -1,50 +1,185 @@
 package mutex
 
 import (
-	"log"
+	"context"
+	"errors"
+	"fmt"
 	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
+// ErrReentrantLock is returned when a context already holding
+// the lock for a key tries to lock the same key again,
+// which would otherwise block forever.
+var ErrReentrantLock = errors.New("lock is already held by this operation")
+
+// defaultWaitReportInterval is how often a warning
+// is logged while waiting to acquire a lock.
+const defaultWaitReportInterval = 30 * time.Second
+
 // KeyValue is a simple key/value
 // store for arbitrary mutexes.
 type KeyValue struct {
 	lock  sync.Mutex
-	store map[string]*sync.Mutex
+	store map[string]*keyLock
+
+	waitReportInterval time.Duration
 }
 
+// keyLock is the lock of a single key. The buffered channel holds a token
+// while the lock is held, so that waiting for it can be canceled.
+type keyLock struct {
+	token    chan struct{}
+	holder   *heldLock
+	waiters  int
+	lockedAt time.Time
+}
+
+// heldLock records a lock acquired with a context, so
+// that nested operations can tell which keys they hold.
+type heldLock struct {
+	mutex  *KeyValue
+	key    string
+	parent *heldLock
+}
+
+type heldLockContextKey struct{}
+
 // New returns a properly initialized KeyValue mutex.
 func New() *KeyValue {
 	return &KeyValue{
-		store: make(map[string]*sync.Mutex),
+		store:              make(map[string]*keyLock),
+		waitReportInterval: defaultWaitReportInterval,
 	}
 }
 
-// Lock the mutex for the given key.
-func (m *KeyValue) Lock(key string) {
-	log.Printf("[DEBUG] Locking mutex for key: %q", key)
-	defer log.Printf("[DEBUG] Locked mutex for key: %q", key)
+// Lock the mutex for the given key, waiting until it's released or the
+// context is done. The returned context records the lock as held, so it
+// must be passed to Unlock and to any operation nested within the lock.
+// Locking a key again with a context that already holds it returns
+// ErrReentrantLock instead of blocking forever.
+func (m *KeyValue) Lock(ctx context.Context, key string) (context.Context, error) {
+	fields := map[string]interface{}{"key": key}
 
-	m.get(key).Lock()
+	lock, err := m.get(ctx, key)
+	if err != nil {
+		return ctx, err
+	}
+
+	started := time.Now()
+	held := &heldLock{mutex: m, key: key, parent: heldLockFromContext(ctx)}
+
+	if err := m.wait(ctx, lock, key, started, fields); err != nil {
+		return ctx, err
+	}
+
+	m.lock.Lock()
+	lock.holder = held
+	lock.lockedAt = time.Now()
+	m.lock.Unlock()
+
+	fields["wait_duration"] = time.Since(started).String()
+	tflog.Debug(ctx, "Locked mutex", fields)
+
+	return context.WithValue(ctx, heldLockContextKey{}, held), nil
 }
 
-// Unlock the mutex for the given key.
-func (m *KeyValue) Unlock(key string) {
-	log.Printf("[DEBUG] Unlocking mutex for key: %q", key)
-	defer log.Printf("[DEBUG] Unlocked mutex for key: %q", key)
+// Unlock the mutex for the given key. The context
+// must be the one returned by the matching Lock.
+func (m *KeyValue) Unlock(ctx context.Context, key string) {
+	m.lock.Lock()
+	lock, ok := m.store[key]
+	if !ok || lock.holder == nil {
+		m.lock.Unlock()
+		panic(fmt.Sprintf("mutex: unlock of unlocked key %q", key))
+	}
+
+	heldFor := time.Since(lock.lockedAt)
+	lock.holder = nil
+	lock.lockedAt = time.Time{}
+	m.lock.Unlock()
+
+	<-lock.token
 
-	m.get(key).Unlock()
+	tflog.Debug(ctx, "Unlocked mutex", map[string]interface{}{
+		"key":           key,
+		"held_duration": heldFor.String(),
+	})
 }
 
-// Returns a mutex for the given key.
-func (m *KeyValue) get(key string) *sync.Mutex {
+// Returns the lock for the given key, after checking
+// that the context doesn't hold it already.
+func (m *KeyValue) get(ctx context.Context, key string) (*keyLock, error) {
 	m.lock.Lock()
 	defer m.lock.Unlock()
 
-	mutex, ok := m.store[key]
+	lock, ok := m.store[key]
 	if !ok {
-		mutex = &sync.Mutex{}
-		m.store[key] = mutex
+		lock = &keyLock{token: make(chan struct{}, 1)}
+		m.store[key] = lock
+	}
+
+	for held := heldLockFromContext(ctx); held != nil; held = held.parent {
+		if held.mutex == m && held.key == key && lock.holder == held {
+			return nil, fmt.Errorf("failed to lock %q: %w", key, ErrReentrantLock)
+		}
+	}
+
+	return lock, nil
+}
+
+// wait blocks until the lock is acquired or the context is
+// done, logging a warning periodically while it's contended.
+func (m *KeyValue) wait(
+	ctx context.Context,
+	lock *keyLock,
+	key string,
+	started time.Time,
+	fields map[string]interface{},
+) error {
+	select {
+	case lock.token <- struct{}{}:
+		return nil
+	default:
 	}
 
-	return mutex
+	m.lock.Lock()
+	lock.waiters++
+	fields["waiters"] = lock.waiters
+	m.lock.Unlock()
+
+	tflog.Debug(ctx, "Waiting for the lock held by another operation", fields)
+
+	defer func() {
+		m.lock.Lock()
+		lock.waiters--
+		m.lock.Unlock()
+	}()
+
+	ticker := time.NewTicker(m.waitReportInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case lock.token <- struct{}{}:
+			return nil
+		case <-ticker.C:
+			fields["wait_duration"] = time.Since(started).String()
+			tflog.Warn(ctx, "Still waiting for the lock held by another operation", fields)
+		case <-ctx.Done():
+			return fmt.Errorf(
+				"failed to lock %q after waiting %s: %w",
+				key,
+				time.Since(started).Round(time.Millisecond),
+				ctx.Err(),
+			)
+		}
+	}
+}
+
+func heldLockFromContext(ctx context.Context) *heldLock {
+	held, _ := ctx.Value(heldLockContextKey{}).(*heldLock)
+	return held
 }
@@ -1,17 +1,24 @@
 package mutex
 
 import (
+	"bytes"
+	"context"
 	"testing"
 	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflogtest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestKeyValueLock(t *testing.T) {
 	keyValueMutex := New()
-	keyValueMutex.Lock("foo")
+	_, err := keyValueMutex.Lock(context.Background(), "foo")
+	require.NoError(t, err)
 
 	doneChannel := make(chan struct{})
 	go func() {
-		keyValueMutex.Lock("foo")
+		_, _ = keyValueMutex.Lock(context.Background(), "foo")
 		close(doneChannel)
 	}()
 
@@ -25,12 +32,13 @@ func TestKeyValueLock(t *testing.T) {
 
 func TestKeyValueUnlock(t *testing.T) {
 	keyValueMutex := New()
-	keyValueMutex.Lock("foo")
-	keyValueMutex.Unlock("foo")
+	ctx, err := keyValueMutex.Lock(context.Background(), "foo")
+	require.NoError(t, err)
+	keyValueMutex.Unlock(ctx, "foo")
 
 	doneChannel := make(chan struct{})
 	go func() {
-		keyValueMutex.Lock("foo")
+		_, _ = keyValueMutex.Lock(context.Background(), "foo")
 		close(doneChannel)
 	}()
 
@@ -44,11 +52,12 @@ func TestKeyValueUnlock(t *testing.T) {
 
 func TestKeyValueDifferentKeys(t *testing.T) {
 	keyValueMutex := New()
-	keyValueMutex.Lock("foo")
+	_, err := keyValueMutex.Lock(context.Background(), "foo")
+	require.NoError(t, err)
 
 	doneChannel := make(chan struct{})
 	go func() {
-		keyValueMutex.Lock("bar")
+		_, _ = keyValueMutex.Lock(context.Background(), "bar")
 		close(doneChannel)
 	}()
 
@@ -59,3 +68,114 @@ func TestKeyValueDifferentKeys(t *testing.T) {
 		t.Fatal("Second lock on a different key blocked. This shouldn't happen.")
 	}
 }
+
+func TestKeyValueLockContextDone(t *testing.T) {
+	keyValueMutex := New()
+	_, err := keyValueMutex.Lock(context.Background(), "foo")
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	_, err = keyValueMutex.Lock(ctx, "foo")
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.ErrorContains(t, err, `failed to lock "foo" after waiting`)
+
+	// The lock is still held by the first operation.
+	select {
+	case keyValueMutex.store["foo"].token <- struct{}{}:
+		t.Fatal("The canceled lock released the key. This shouldn't happen.")
+	default:
+		// Test passing.
+	}
+}
+
+func TestKeyValueLockReentrant(t *testing.T) {
+	keyValueMutex := New()
+	ctx, err := keyValueMutex.Lock(context.Background(), "foo")
+	require.NoError(t, err)
+
+	// Nested operations can lock other keys with the context.
+	nestedCtx, err := keyValueMutex.Lock(ctx, "bar")
+	require.NoError(t, err)
+
+	_, err = keyValueMutex.Lock(nestedCtx, "foo")
+	assert.ErrorIs(t, err, ErrReentrantLock)
+	assert.EqualError(t, err, `failed to lock "foo": lock is already held by this operation`)
+
+	_, err = keyValueMutex.Lock(nestedCtx, "bar")
+	assert.ErrorIs(t, err, ErrReentrantLock)
+
+	// Once unlocked, the key can be locked again with the same context.
+	keyValueMutex.Unlock(nestedCtx, "bar")
+	_, err = keyValueMutex.Lock(nestedCtx, "bar")
+	assert.NoError(t, err)
+
+	// A lock held by the context of another KeyValue isn't reentrant.
+	otherCtx, err := New().Lock(context.Background(), "foo")
+	require.NoError(t, err)
+	otherCtx, cancel := context.WithTimeout(otherCtx, 10*time.Millisecond)
+	defer cancel()
+
+	_, err = keyValueMutex.Lock(otherCtx, "foo")
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func TestKeyValueUnlockUnlocked(t *testing.T) {
+	keyValueMutex := New()
+
+	assert.PanicsWithValue(t, `mutex: unlock of unlocked key "foo"`, func() {
+		keyValueMutex.Unlock(context.Background(), "foo")
+	})
+}
+
+func TestKeyValueLockLogging(t *testing.T) {
+	keyValueMutex := New()
+	keyValueMutex.waitReportInterval = 20 * time.Millisecond
+
+	holderCtx, err := keyValueMutex.Lock(context.Background(), "foo")
+	require.NoError(t, err)
+
+	var output bytes.Buffer
+	ctx := tflogtest.RootLogger(context.Background(), &output)
+
+	doneChannel := make(chan struct{})
+	go func() {
+		defer close(doneChannel)
+
+		waiterCtx, err := keyValueMutex.Lock(ctx, "foo")
+		if err != nil {
+			t.Errorf("Unexpected error: %v", err)
+			return
+		}
+		keyValueMutex.Unlock(waiterCtx, "foo")
+	}()
+
+	time.Sleep(70 * time.Millisecond)
+	keyValueMutex.Unlock(holderCtx, "foo")
+	<-doneChannel
+
+	entries, err := tflogtest.MultilineJSONDecode(&output)
+	require.NoError(t, err)
+	require.GreaterOrEqual(t, len(entries), 4)
+
+	assert.Equal(t, "Waiting for the lock held by another operation", entries[0]["@message"])
+	assert.Equal(t, "foo", entries[0]["key"])
+	assert.Equal(t, float64(1), entries[0]["waiters"])
+
+	for _, entry := range entries[1 : len(entries)-2] {
+		assert.Equal(t, "Still waiting for the lock held by another operation", entry["@message"])
+		assert.Equal(t, "warn", entry["@level"])
+		assert.NotEmpty(t, entry["wait_duration"])
+	}
+
+	locked := entries[len(entries)-2]
+	assert.Equal(t, "Locked mutex", locked["@message"])
+	waited, err := time.ParseDuration(locked["wait_duration"].(string))
+	require.NoError(t, err)
+	assert.GreaterOrEqual(t, waited, 50*time.Millisecond)
+
+	unlocked := entries[len(entries)-1]
+	assert.Equal(t, "Unlocked mutex", unlocked["@message"])
+	assert.NotEmpty(t, unlocked["held_duration"])
+}
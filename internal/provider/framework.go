@@ -0,0 +1,246 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	fwprovider "github.com/hashicorp/terraform-plugin-framework/provider"
+	fwschema "github.com/hashicorp/terraform-plugin-framework/provider/schema"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-mux/tf5muxserver"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/auth0/terraform-provider-auth0/internal/auth0/action"
+	"github.com/auth0/terraform-provider-auth0/internal/auth0/client"
+	"github.com/auth0/terraform-provider-auth0/internal/auth0/connection"
+	"github.com/auth0/terraform-provider-auth0/internal/auth0/organization"
+	"github.com/auth0/terraform-provider-auth0/internal/auth0/resourceserver"
+	"github.com/auth0/terraform-provider-auth0/internal/auth0/role"
+	"github.com/auth0/terraform-provider-auth0/internal/auth0/user"
+)
+
+// NewMuxServer returns the provider server muxing the SDKv2 provider with
+// the plugin framework one, which serves the ephemeral resources, list resources and functions.
+func NewMuxServer(ctx context.Context, sdkProvider *schema.Provider) (func() tfprotov5.ProviderServer, error) {
+	muxServer, err := tf5muxserver.NewMuxServer(
+		ctx,
+		// The SDKv2 provider must come first, so it is configured before the framework one.
+		sdkProvider.GRPCProvider,
+		providerserver.NewProtocol5(NewFramework(sdkProvider)),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return muxServer.ProviderServer, nil
+}
+
+// NewFramework returns the plugin framework provider, which
+// shares the configuration of the given SDKv2 provider.
+func NewFramework(sdkProvider *schema.Provider) fwprovider.Provider {
+	return &frameworkProvider{
+		sdkProvider: sdkProvider,
+	}
+}
+
+type frameworkProvider struct {
+	sdkProvider *schema.Provider
+}
+
+var (
+	_ fwprovider.ProviderWithEphemeralResources = &frameworkProvider{}
+	_ fwprovider.ProviderWithFunctions          = &frameworkProvider{}
+	_ fwprovider.ProviderWithListResources      = &frameworkProvider{}
+)
+
+func (p *frameworkProvider) Metadata(_ context.Context, _ fwprovider.MetadataRequest, resp *fwprovider.MetadataResponse) {
+	resp.TypeName = "auth0"
+}
+
+// Schema mirrors the schema of the SDKv2 provider, as the
+// provider schemas of muxed servers must be identical.
+func (p *frameworkProvider) Schema(_ context.Context, _ fwprovider.SchemaRequest, resp *fwprovider.SchemaResponse) {
+	attributes, blocks := frameworkSchema(p.sdkProvider.Schema)
+
+	resp.Schema = fwschema.Schema{
+		Attributes: attributes,
+		Blocks:     blocks,
+	}
+}
+
+// Configure hands the *config.Config of the SDKv2 provider, which
+// the mux server configures first, over to the framework resources.
+func (p *frameworkProvider) Configure(_ context.Context, _ fwprovider.ConfigureRequest, resp *fwprovider.ConfigureResponse) {
+	resp.EphemeralResourceData = p.sdkProvider.Meta()
+	resp.ListResourceData = p.sdkProvider.Meta()
+}
+
+func (p *frameworkProvider) Resources(_ context.Context) []func() resource.Resource {
+	return nil
+}
+
+func (p *frameworkProvider) DataSources(_ context.Context) []func() datasource.DataSource {
+	return nil
+}
+
+func (p *frameworkProvider) EphemeralResources(_ context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		client.NewCredentialsTokenEphemeralResource,
+	}
+}
+
+func (p *frameworkProvider) ListResources(_ context.Context) []func() list.ListResource {
+	return []func() list.ListResource{
+		action.NewListResource,
+		client.NewListResource,
+		connection.NewListResource,
+		organization.NewListResource,
+		resourceserver.NewListResource,
+		role.NewListResource,
+		user.NewListResource,
+	}
+}
+
+func (p *frameworkProvider) Functions(_ context.Context) []func() function.Function {
+	return []func() function.Function{
+		connection.NewSAMLMetadataFunction,
+	}
+}
+
+// frameworkSchema converts the SDKv2 schema into plugin framework attributes and blocks.
+// Only the schema types used by the provider configuration are supported.
+func frameworkSchema(sdkSchema map[string]*schema.Schema) (map[string]fwschema.Attribute, map[string]fwschema.Block) {
+	attributes := make(map[string]fwschema.Attribute)
+	blocks := make(map[string]fwschema.Block)
+
+	for name, sdkAttribute := range sdkSchema {
+		description, markdownDescription := frameworkDescription(sdkAttribute.Description)
+
+		if elem, ok := sdkAttribute.Elem.(*schema.Resource); ok {
+			nestedAttributes, nestedBlocks := frameworkSchema(elem.Schema)
+			nestedObject := fwschema.NestedBlockObject{
+				Attributes: nestedAttributes,
+				Blocks:     nestedBlocks,
+			}
+
+			if sdkAttribute.Type == schema.TypeSet {
+				blocks[name] = fwschema.SetNestedBlock{
+					NestedObject:        nestedObject,
+					Description:         description,
+					MarkdownDescription: markdownDescription,
+					DeprecationMessage:  sdkAttribute.Deprecated,
+				}
+				continue
+			}
+
+			blocks[name] = fwschema.ListNestedBlock{
+				NestedObject:        nestedObject,
+				Description:         description,
+				MarkdownDescription: markdownDescription,
+				DeprecationMessage:  sdkAttribute.Deprecated,
+			}
+			continue
+		}
+
+		switch sdkAttribute.Type {
+		case schema.TypeString:
+			attributes[name] = fwschema.StringAttribute{
+				Required:            sdkAttribute.Required,
+				Optional:            sdkAttribute.Optional,
+				Sensitive:           sdkAttribute.Sensitive,
+				Description:         description,
+				MarkdownDescription: markdownDescription,
+				DeprecationMessage:  sdkAttribute.Deprecated,
+			}
+		case schema.TypeBool:
+			attributes[name] = fwschema.BoolAttribute{
+				Required:            sdkAttribute.Required,
+				Optional:            sdkAttribute.Optional,
+				Sensitive:           sdkAttribute.Sensitive,
+				Description:         description,
+				MarkdownDescription: markdownDescription,
+				DeprecationMessage:  sdkAttribute.Deprecated,
+			}
+		case schema.TypeInt:
+			attributes[name] = fwschema.Int64Attribute{
+				Required:            sdkAttribute.Required,
+				Optional:            sdkAttribute.Optional,
+				Sensitive:           sdkAttribute.Sensitive,
+				Description:         description,
+				MarkdownDescription: markdownDescription,
+				DeprecationMessage:  sdkAttribute.Deprecated,
+			}
+		case schema.TypeList:
+			attributes[name] = fwschema.ListAttribute{
+				ElementType:         frameworkElementType(sdkAttribute),
+				Required:            sdkAttribute.Required,
+				Optional:            sdkAttribute.Optional,
+				Sensitive:           sdkAttribute.Sensitive,
+				Description:         description,
+				MarkdownDescription: markdownDescription,
+				DeprecationMessage:  sdkAttribute.Deprecated,
+			}
+		case schema.TypeSet:
+			attributes[name] = fwschema.SetAttribute{
+				ElementType:         frameworkElementType(sdkAttribute),
+				Required:            sdkAttribute.Required,
+				Optional:            sdkAttribute.Optional,
+				Sensitive:           sdkAttribute.Sensitive,
+				Description:         description,
+				MarkdownDescription: markdownDescription,
+				DeprecationMessage:  sdkAttribute.Deprecated,
+			}
+		case schema.TypeMap:
+			attributes[name] = fwschema.MapAttribute{
+				ElementType:         frameworkElementType(sdkAttribute),
+				Required:            sdkAttribute.Required,
+				Optional:            sdkAttribute.Optional,
+				Sensitive:           sdkAttribute.Sensitive,
+				Description:         description,
+				MarkdownDescription: markdownDescription,
+				DeprecationMessage:  sdkAttribute.Deprecated,
+			}
+		default:
+			panic(fmt.Sprintf("unsupported provider attribute type %s for %q", sdkAttribute.Type, name))
+		}
+	}
+
+	return attributes, blocks
+}
+
+// frameworkElementType returns the element type of an SDKv2 list, set or map of primitives.
+func frameworkElementType(sdkAttribute *schema.Schema) attr.Type {
+	elemType := schema.TypeString
+	if elem, ok := sdkAttribute.Elem.(*schema.Schema); ok {
+		elemType = elem.Type
+	}
+
+	switch elemType {
+	case schema.TypeBool:
+		return types.BoolType
+	case schema.TypeInt:
+		return types.Int64Type
+	case schema.TypeFloat:
+		return types.Float64Type
+	default:
+		return types.StringType
+	}
+}
+
+// frameworkDescription returns the description as plain text or
+// markdown, depending on the description kind of the SDKv2 provider.
+func frameworkDescription(description string) (string, string) {
+	if schema.DescriptionKind == schema.StringMarkdown {
+		return "", description
+	}
+
+	return description, ""
+}
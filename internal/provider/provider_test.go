@@ -1,12 +1,15 @@
 package provider
 
 import (
+	"context"
 	"os"
 	"sort"
 	"strings"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 )
@@ -21,6 +24,51 @@ func TestProvider(t *testing.T) {
 	}
 }
 
+func TestNewMuxServer(t *testing.T) {
+	for _, descriptionKind := range []schema.StringKind{schema.StringPlain, schema.StringMarkdown} {
+		defaultDescriptionKind := schema.DescriptionKind
+		schema.DescriptionKind = descriptionKind
+
+		providerServer, err := NewMuxServer(context.Background(), New())
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		// The mux server fails when the provider schemas of the SDKv2 and framework providers differ.
+		resp, err := providerServer().GetProviderSchema(context.Background(), &tfprotov5.GetProviderSchemaRequest{})
+		schema.DescriptionKind = defaultDescriptionKind
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		for _, diagnostic := range resp.Diagnostics {
+			t.Errorf("%s: %s", diagnostic.Summary, diagnostic.Detail)
+		}
+
+		if _, ok := resp.EphemeralResourceSchemas["auth0_client_credentials_token"]; !ok {
+			t.Error("Expected the auth0_client_credentials_token ephemeral resource to be served")
+		}
+
+		if _, ok := resp.Functions["saml_metadata"]; !ok {
+			t.Error("Expected the saml_metadata function to be served")
+		}
+
+		for _, name := range []string{
+			"auth0_action",
+			"auth0_client",
+			"auth0_connection",
+			"auth0_organization",
+			"auth0_resource_server",
+			"auth0_role",
+			"auth0_user",
+		} {
+			if _, ok := resp.ListResourceSchemas[name]; !ok {
+				t.Errorf("Expected the %s list resource to be served", name)
+			}
+		}
+	}
+}
+
 func TestProvider_debugDefaults(t *testing.T) {
 	for value, expected := range map[string]bool{
 		"1":     true,
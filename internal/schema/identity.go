@@ -0,0 +1,169 @@
+package schema
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// IdentityIDAttribute is the identity attribute holding the ID of the resource.
+const IdentityIDAttribute = "id"
+
+// WithIDIdentity gives the resource an identity made of its ID, which Terraform uses for
+// import blocks with an identity and to identify the results of list resources.
+// The identity is set once the resource gets created, read or updated, and
+// importing by identity falls back to the importer of the resource.
+func WithIDIdentity(resource *schema.Resource) *schema.Resource {
+	resource.Identity = &schema.ResourceIdentity{
+		SchemaFunc: func() map[string]*schema.Schema {
+			return map[string]*schema.Schema{
+				IdentityIDAttribute: {
+					Type:              schema.TypeString,
+					RequiredForImport: true,
+					Description:       "The ID of the resource.",
+				},
+			}
+		},
+	}
+
+	setIdentity := func(data *schema.ResourceData, identity *schema.IdentityData) error {
+		return identity.Set(IdentityIDAttribute, data.Id())
+	}
+
+	resource.CreateContext = withIdentity(resource.CreateContext, setIdentity)
+	resource.ReadContext = withIdentity(resource.ReadContext, setIdentity)
+	resource.UpdateContext = withIdentity(resource.UpdateContext, setIdentity)
+
+	if resource.Importer != nil && resource.Importer.StateContext != nil {
+		resource.Importer.StateContext = importIdentity(
+			schema.ImportStatePassthroughWithIdentity(IdentityIDAttribute),
+			resource.Importer.StateContext,
+		)
+	}
+
+	return resource
+}
+
+// WithGroupIdentity gives the resource an identity made of the attributes its
+// ID is built from with SetResourceGroupID, e.g. the organization_id and
+// user_id of an organization member, so they can be imported without having
+// to join them with "::". Importing by identity builds the ID of the resource
+// from the identity, before falling back to the importer of the resource.
+func WithGroupIdentity(resource *schema.Resource, resourceGroup ...string) *schema.Resource {
+	resource.Identity = &schema.ResourceIdentity{
+		SchemaFunc: func() map[string]*schema.Schema {
+			identitySchema := make(map[string]*schema.Schema, len(resourceGroup))
+			for _, attribute := range resourceGroup {
+				description := fmt.Sprintf("The %s of the resource.", attribute)
+				if attributeSchema, ok := resource.SchemaMap()[attribute]; ok && attributeSchema.Description != "" {
+					description = attributeSchema.Description
+				}
+
+				identitySchema[attribute] = &schema.Schema{
+					Type:              schema.TypeString,
+					RequiredForImport: true,
+					Description:       description,
+				}
+			}
+
+			return identitySchema
+		},
+	}
+
+	setIdentity := func(data *schema.ResourceData, identity *schema.IdentityData) error {
+		idGroup := strings.Split(data.Id(), separator)
+		if len(idGroup) != len(resourceGroup) {
+			// Leaving the identity empty would let a malformed ID through.
+			return fmt.Errorf("failed to set the identity of %q: %w", data.Id(), errInvalidID(resourceGroup...))
+		}
+
+		for index, attribute := range resourceGroup {
+			if err := identity.Set(attribute, idGroup[index]); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+
+	resource.CreateContext = withIdentity(resource.CreateContext, setIdentity)
+	resource.ReadContext = withIdentity(resource.ReadContext, setIdentity)
+	resource.UpdateContext = withIdentity(resource.UpdateContext, setIdentity)
+
+	if resource.Importer != nil && resource.Importer.StateContext != nil {
+		resource.Importer.StateContext = importIdentity(
+			func(_ context.Context, data *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+				if data.Id() != "" {
+					return []*schema.ResourceData{data}, nil
+				}
+
+				identity, err := data.Identity()
+				if err != nil {
+					return nil, err
+				}
+
+				idGroup := make([]string, 0, len(resourceGroup))
+				for _, attribute := range resourceGroup {
+					value, ok := identity.GetOk(attribute)
+					if !ok {
+						return nil, fmt.Errorf("the %s identity attribute is required for import", attribute)
+					}
+					idGroup = append(idGroup, value.(string))
+				}
+
+				SetResourceGroupID(data, idGroup...)
+
+				return []*schema.ResourceData{data}, nil
+			},
+			resource.Importer.StateContext,
+		)
+	}
+
+	return resource
+}
+
+// importIdentity runs the importer setting the ID of the resource from
+// its identity, if any, before running the importer of the resource.
+func importIdentity(importIdentity, importState schema.StateContextFunc) schema.StateContextFunc {
+	return func(ctx context.Context, data *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+		if _, err := importIdentity(ctx, data, meta); err != nil {
+			return nil, err
+		}
+
+		return importState(ctx, data, meta)
+	}
+}
+
+type operationFunc = func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics
+
+func withIdentity(
+	operation operationFunc,
+	setIdentity func(data *schema.ResourceData, identity *schema.IdentityData) error,
+) operationFunc {
+	if operation == nil {
+		return nil
+	}
+
+	return func(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		diagnostics := operation(ctx, data, meta)
+
+		// The resource is gone or was never created.
+		if data.Id() == "" {
+			return diagnostics
+		}
+
+		identity, err := data.Identity()
+		if err != nil {
+			return append(diagnostics, diag.FromErr(err)...)
+		}
+
+		if err := setIdentity(data, identity); err != nil {
+			return append(diagnostics, diag.FromErr(err)...)
+		}
+
+		return diagnostics
+	}
+}
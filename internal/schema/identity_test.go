@@ -0,0 +1,146 @@
+package schema
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWithIDIdentity(t *testing.T) {
+	t.Run("it sets the identity on create", func(t *testing.T) {
+		testResource := testListedResource()
+		data := testResource.Data(nil)
+
+		diagnostics := testResource.CreateContext(context.Background(), data, nil)
+		require.False(t, diagnostics.HasError())
+
+		identity, err := data.Identity()
+		require.NoError(t, err)
+		assert.Equal(t, "id-1", identity.Get("id"))
+	})
+
+	t.Run("it imports by identity", func(t *testing.T) {
+		testResource := testListedResource()
+		data := testResource.Data(nil)
+
+		identity, err := data.Identity()
+		require.NoError(t, err)
+		require.NoError(t, identity.Set("id", "id-2"))
+
+		imported, err := testResource.Importer.StateContext(context.Background(), data, nil)
+		require.NoError(t, err)
+		require.Len(t, imported, 1)
+		assert.Equal(t, "id-2", imported[0].Id())
+	})
+}
+
+func testGroupResource() *schema.Resource {
+	return WithGroupIdentity(&schema.Resource{
+		CreateContext: func(_ context.Context, data *schema.ResourceData, _ interface{}) diag.Diagnostics {
+			SetResourceGroupID(data, data.Get("organization_id").(string), data.Get("user_id").(string))
+			return nil
+		},
+		ReadContext:   schema.NoopContext,
+		DeleteContext: schema.NoopContext,
+		Importer: &schema.ResourceImporter{
+			StateContext: ImportResourceGroupID("organization_id", "user_id"),
+		},
+		Schema: map[string]*schema.Schema{
+			"organization_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID of the organization.",
+			},
+			"user_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}, "organization_id", "user_id")
+}
+
+func TestWithGroupIdentity(t *testing.T) {
+	t.Run("it describes the identity with the attributes of the resource", func(t *testing.T) {
+		identitySchema, err := testGroupResource().CoreIdentitySchema()
+		require.NoError(t, err)
+
+		assert.Equal(t, "The ID of the organization.", identitySchema.Attributes["organization_id"].Description)
+		assert.Equal(t, "The user_id of the resource.", identitySchema.Attributes["user_id"].Description)
+		assert.True(t, identitySchema.Attributes["user_id"].RequiredForImport)
+	})
+
+	t.Run("it sets the identity on create", func(t *testing.T) {
+		testResource := testGroupResource()
+		data := testResource.Data(nil)
+		require.NoError(t, data.Set("organization_id", "org_1234"))
+		require.NoError(t, data.Set("user_id", "auth0|62d82"))
+
+		diagnostics := testResource.CreateContext(context.Background(), data, nil)
+		require.False(t, diagnostics.HasError())
+
+		identity, err := data.Identity()
+		require.NoError(t, err)
+		assert.Equal(t, "org_1234", identity.Get("organization_id"))
+		assert.Equal(t, "auth0|62d82", identity.Get("user_id"))
+	})
+
+	t.Run("it fails for IDs that are not resource groups", func(t *testing.T) {
+		testResource := testGroupResource()
+		data := testResource.Data(nil)
+		data.SetId("org_1234")
+
+		diagnostics := testResource.ReadContext(context.Background(), data, nil)
+		require.Len(t, diagnostics, 1)
+		assert.Equal(t, diag.Error, diagnostics[0].Severity)
+		assert.Equal(
+			t,
+			`failed to set the identity of "org_1234": ID must be formatted as <organization_id>::<user_id>`,
+			diagnostics[0].Summary,
+		)
+	})
+
+	t.Run("it imports by identity", func(t *testing.T) {
+		testResource := testGroupResource()
+		data := testResource.Data(nil)
+
+		identity, err := data.Identity()
+		require.NoError(t, err)
+		require.NoError(t, identity.Set("organization_id", "org_1234"))
+		require.NoError(t, identity.Set("user_id", "auth0|62d82"))
+
+		imported, err := testResource.Importer.StateContext(context.Background(), data, nil)
+		require.NoError(t, err)
+		require.Len(t, imported, 1)
+		assert.Equal(t, "org_1234::auth0|62d82", imported[0].Id())
+		assert.Equal(t, "org_1234", imported[0].Get("organization_id"))
+		assert.Equal(t, "auth0|62d82", imported[0].Get("user_id"))
+	})
+
+	t.Run("it fails to import by an incomplete identity", func(t *testing.T) {
+		testResource := testGroupResource()
+		data := testResource.Data(nil)
+
+		identity, err := data.Identity()
+		require.NoError(t, err)
+		require.NoError(t, identity.Set("organization_id", "org_1234"))
+
+		_, err = testResource.Importer.StateContext(context.Background(), data, nil)
+		assert.EqualError(t, err, "the user_id identity attribute is required for import")
+	})
+
+	t.Run("it still imports by ID", func(t *testing.T) {
+		testResource := testGroupResource()
+		data := testResource.Data(nil)
+		data.SetId("org_1234::auth0|62d82")
+
+		imported, err := testResource.Importer.StateContext(context.Background(), data, nil)
+		require.NoError(t, err)
+		assert.Equal(t, "auth0|62d82", imported[0].Get("user_id"))
+	})
+}
@@ -13,10 +13,78 @@ const separator = "::"
 
 var errEmptyID = fmt.Errorf("ID cannot be empty")
 
+// ImportIDResolver resolves the ID of a resource from the value
+// of a human-readable import ID, e.g. "my-app" for "name:my-app".
+type ImportIDResolver func(ctx context.Context, meta interface{}, value string) (string, error)
+
+// ImportIDResolvers holds the resolvers of the human-readable import IDs of a resource by prefix, e.g. "name".
+type ImportIDResolvers map[string]ImportIDResolver
+
+// Resolve returns the ID of the resource the given import ID points to.
+// IDs without the prefix of one of the resolvers are returned as is.
+func (r ImportIDResolvers) Resolve(ctx context.Context, meta interface{}, importID string) (string, error) {
+	prefix, value, found := strings.Cut(importID, ":")
+	if !found {
+		return importID, nil
+	}
+
+	resolver, ok := r[prefix]
+	if !ok {
+		return importID, nil
+	}
+
+	if value == "" {
+		return "", fmt.Errorf("the %s of the import ID %q cannot be empty", prefix, importID)
+	}
+
+	return resolver(ctx, meta, value)
+}
+
+// UniqueImportID returns the only ID matching a human-readable import ID,
+// erroring when there are no matches or when the matches are ambiguous.
+func UniqueImportID(resourceType, attribute, value string, ids []string) (string, error) {
+	switch len(ids) {
+	case 0:
+		return "", fmt.Errorf("no %s found with %s %q", resourceType, attribute, value)
+	case 1:
+		return ids[0], nil
+	}
+
+	return "", fmt.Errorf(
+		"found %d %ss with %s %q (%s), import the %s by ID instead",
+		len(ids), resourceType, attribute, value, strings.Join(ids, ", "), resourceType,
+	)
+}
+
+// ImportStateResolvingID resolves human-readable import IDs like "name:my-app"
+// into the ID of the resource, before running the given importer.
+func ImportStateResolvingID(resolvers ImportIDResolvers, importer schema.StateContextFunc) schema.StateContextFunc {
+	return func(ctx context.Context, data *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+		id, err := resolvers.Resolve(ctx, meta, data.Id())
+		if err != nil {
+			return nil, err
+		}
+
+		data.SetId(id)
+
+		return importer(ctx, data, meta)
+	}
+}
+
 // ImportResourceGroupID deconstructs the given ID when terraform import
 // runs, so the attribute groups can be set within the terraform state.
 func ImportResourceGroupID(resourceGroup ...string) schema.StateContextFunc {
-	return func(_ context.Context, data *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	return ImportResourceGroupIDResolving(nil, resourceGroup...)
+}
+
+// ImportResourceGroupIDResolving works like ImportResourceGroupID, resolving
+// the human-readable segments of the ID with the resolvers of their attribute,
+// e.g. "name:my-org::name:my-app" for the organization_id and client_id.
+func ImportResourceGroupIDResolving(
+	resolvers map[string]ImportIDResolvers,
+	resourceGroup ...string,
+) schema.StateContextFunc {
+	return func(ctx context.Context, data *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 		givenRawID := data.Id()
 		if givenRawID == "" {
 			return nil, errEmptyID
@@ -31,6 +99,18 @@ func ImportResourceGroupID(resourceGroup ...string) schema.StateContextFunc {
 			return nil, errInvalidID(resourceGroup...)
 		}
 
+		if len(resolvers) > 0 {
+			for index, attribute := range resourceGroup {
+				id, err := resolvers[attribute].Resolve(ctx, meta, idGroup[index])
+				if err != nil {
+					return nil, fmt.Errorf("failed to resolve the %s: %w", attribute, err)
+				}
+				idGroup[index] = id
+			}
+
+			SetResourceGroupID(data, idGroup...)
+		}
+
 		var result *multierror.Error
 		for index, attribute := range resourceGroup {
 			result = multierror.Append(result, data.Set(attribute, idGroup[index]))
@@ -118,3 +118,97 @@ func TestImportResourceGroupID(t *testing.T) {
 		})
 	}
 }
+
+var testImportIDResolvers = ImportIDResolvers{
+	"name": func(_ context.Context, _ interface{}, value string) (string, error) {
+		ids := map[string][]string{
+			"My Org": {"org_1234"},
+			"My App": {"client_1234"},
+			"Twins":  {"client_1", "client_2"},
+		}[value]
+
+		return UniqueImportID("thing", "name", value, ids)
+	},
+}
+
+func TestImportIDResolvers_Resolve(t *testing.T) {
+	var testCases = []struct {
+		importID      string
+		expectedID    string
+		expectedError string
+	}{
+		{importID: "org_1234", expectedID: "org_1234"},
+		{importID: "auth0|62d82", expectedID: "auth0|62d82"},
+		{importID: "https://api.example.com", expectedID: "https://api.example.com"},
+		{importID: "name:My Org", expectedID: "org_1234"},
+		{importID: "name:", expectedError: `the name of the import ID "name:" cannot be empty`},
+		{importID: "name:Unknown", expectedError: `no thing found with name "Unknown"`},
+		{
+			importID:      "name:Twins",
+			expectedError: `found 2 things with name "Twins" (client_1, client_2), import the thing by ID instead`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.importID, func(t *testing.T) {
+			id, err := testImportIDResolvers.Resolve(context.Background(), nil, testCase.importID)
+			if testCase.expectedError != "" {
+				assert.EqualError(t, err, testCase.expectedError)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, testCase.expectedID, id)
+		})
+	}
+}
+
+func TestImportStateResolvingID(t *testing.T) {
+	data := schema.TestResourceDataRaw(t, map[string]*schema.Schema{}, nil)
+	data.SetId("name:My App")
+
+	importFunc := ImportStateResolvingID(testImportIDResolvers, schema.ImportStatePassthroughContext)
+	actualData, err := importFunc(context.Background(), data, nil)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "client_1234", actualData[0].Id())
+}
+
+func TestImportResourceGroupIDResolving(t *testing.T) {
+	testSchema := map[string]*schema.Schema{
+		"organization_id": {Type: schema.TypeString, Required: true},
+		"client_id":       {Type: schema.TypeString, Required: true},
+	}
+	resolvers := map[string]ImportIDResolvers{
+		"organization_id": testImportIDResolvers,
+		"client_id":       testImportIDResolvers,
+	}
+
+	t.Run("it resolves the human-readable segments of the ID", func(t *testing.T) {
+		data := schema.TestResourceDataRaw(t, testSchema, nil)
+		data.SetId("name:My Org::client_5678")
+
+		importFunc := ImportResourceGroupIDResolving(resolvers, "organization_id", "client_id")
+		actualData, err := importFunc(context.Background(), data, nil)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "org_1234::client_5678", actualData[0].Id())
+		assert.Equal(t, "org_1234", actualData[0].Get("organization_id"))
+		assert.Equal(t, "client_5678", actualData[0].Get("client_id"))
+	})
+
+	t.Run("it fails when a segment is ambiguous", func(t *testing.T) {
+		data := schema.TestResourceDataRaw(t, testSchema, nil)
+		data.SetId("org_1234::name:Twins")
+
+		importFunc := ImportResourceGroupIDResolving(resolvers, "organization_id", "client_id")
+		actualData, err := importFunc(context.Background(), data, nil)
+
+		assert.EqualError(
+			t,
+			err,
+			`failed to resolve the client_id: found 2 things with name "Twins" (client_1, client_2), import the thing by ID instead`,
+		)
+		assert.Nil(t, actualData)
+	})
+}
@@ -0,0 +1,207 @@
+package schema
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/go-cty/cty/msgpack"
+	fwdiag "github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+// ListItem is an instance of a resource found by a ListFunc.
+type ListItem struct {
+	ID          string
+	DisplayName string
+}
+
+// ListFunc lists the instances of a resource matching the filters of the list block,
+// passing each of them to yield until it returns false.
+type ListFunc func(
+	ctx context.Context,
+	filters tfsdk.Config,
+	meta interface{},
+	yield func(ListItem) bool,
+) fwdiag.Diagnostics
+
+// ListResourceConfig holds what a list resource needs to list the instances of a resource.
+type ListResourceConfig struct {
+	// TypeName is the name of the listed resource, without the provider prefix, e.g. "_client".
+	TypeName string
+
+	// Resource returns the listed resource, which must have an identity.
+	Resource func() *schema.Resource
+
+	// Schema is the schema of the filters of the list block.
+	Schema listschema.Schema
+
+	// List lists the instances of the resource.
+	List ListFunc
+}
+
+var (
+	_ list.ListResourceWithConfigure    = &listResource{}
+	_ list.ListResourceWithRawV5Schemas = &listResource{}
+)
+
+// NewListResource returns a list resource for a resource managed with the SDKv2. Each result
+// carries the identity of the instance and, when requested, its state as read by the resource.
+func NewListResource(config ListResourceConfig) list.ListResource {
+	return &listResource{
+		config: config,
+	}
+}
+
+type listResource struct {
+	config ListResourceConfig
+	meta   interface{}
+}
+
+func (r *listResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + r.config.TypeName
+}
+
+func (r *listResource) ListResourceConfigSchema(
+	_ context.Context,
+	_ list.ListResourceSchemaRequest,
+	resp *list.ListResourceSchemaResponse,
+) {
+	resp.Schema = r.config.Schema
+}
+
+func (r *listResource) RawV5Schemas(ctx context.Context, _ list.RawV5SchemaRequest, resp *list.RawV5SchemaResponse) {
+	sdkResource := r.config.Resource()
+
+	resp.ProtoV5Schema = sdkResource.ProtoSchema(ctx)()
+	resp.ProtoV5IdentitySchema = sdkResource.ProtoIdentitySchema(ctx)()
+}
+
+func (r *listResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.meta = req.ProviderData
+}
+
+func (r *listResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	if r.meta == nil {
+		stream.Results = list.ListResultsStreamDiagnostics(fwdiag.Diagnostics{
+			fwdiag.NewErrorDiagnostic(
+				"Unconfigured Provider",
+				"The provider must be configured before listing resources.",
+			),
+		})
+		return
+	}
+
+	sdkResource := r.config.Resource()
+
+	stream.Results = func(push func(list.ListResult) bool) {
+		var count int64
+
+		diagnostics := r.config.List(ctx, req.Config, r.meta, func(item ListItem) bool {
+			result := req.NewListResult(ctx)
+			result.DisplayName = item.DisplayName
+			result.Diagnostics.Append(result.Identity.SetAttribute(ctx, path.Root(IdentityIDAttribute), item.ID)...)
+
+			if req.IncludeResource && !result.Diagnostics.HasError() {
+				raw, found, diagnostics := readListItem(ctx, sdkResource, req, item.ID, r.meta)
+				result.Diagnostics.Append(diagnostics...)
+
+				// The instance got deleted in the meantime.
+				if !found && !result.Diagnostics.HasError() {
+					return true
+				}
+
+				result.Resource.Raw = raw
+			}
+
+			count++
+			if !push(result) {
+				return false
+			}
+
+			return req.Limit <= 0 || count < req.Limit
+		})
+
+		if len(diagnostics) > 0 {
+			push(list.ListResult{Diagnostics: diagnostics})
+		}
+	}
+}
+
+// readListItem reads the instance with the given ID through the resource,
+// returning its state in the type of the resource schema of the request.
+func readListItem(
+	ctx context.Context,
+	sdkResource *schema.Resource,
+	req list.ListRequest,
+	id string,
+	meta interface{},
+) (tftypes.Value, bool, fwdiag.Diagnostics) {
+	resourceType := req.ResourceSchema.Type().TerraformType(ctx)
+	null := tftypes.NewValue(resourceType, nil)
+
+	state, diagnostics := sdkResource.RefreshWithoutUpgrade(
+		ctx,
+		&terraform.InstanceState{ID: id, Attributes: map[string]string{"id": id}},
+		meta,
+	)
+	if diagnostics.HasError() || state == nil || state.ID == "" {
+		return null, false, frameworkDiagnostics(diagnostics)
+	}
+
+	ctyType := sdkResource.CoreConfigSchema().ImpliedType()
+
+	value, err := state.AttrsAsObjectValue(ctyType)
+	if err != nil {
+		return null, false, readListItemError(id, err)
+	}
+
+	packed, err := msgpack.Marshal(value, ctyType)
+	if err != nil {
+		return null, false, readListItemError(id, err)
+	}
+
+	raw, err := (&tfprotov5.DynamicValue{MsgPack: packed}).Unmarshal(resourceType)
+	if err != nil {
+		return null, false, readListItemError(id, err)
+	}
+
+	return raw, true, frameworkDiagnostics(diagnostics)
+}
+
+func readListItemError(id string, err error) fwdiag.Diagnostics {
+	return fwdiag.Diagnostics{
+		fwdiag.NewErrorDiagnostic(
+			"Failed to Read Listed Resource",
+			fmt.Sprintf("Failed to convert the state of %q: %s", id, err),
+		),
+	}
+}
+
+// frameworkDiagnostics converts SDKv2 diagnostics into plugin framework ones.
+func frameworkDiagnostics(diagnostics diag.Diagnostics) fwdiag.Diagnostics {
+	var converted fwdiag.Diagnostics
+
+	for _, diagnostic := range diagnostics {
+		if diagnostic.Severity == diag.Error {
+			converted.AddError(diagnostic.Summary, diagnostic.Detail)
+			continue
+		}
+
+		converted.AddWarning(diagnostic.Summary, diagnostic.Detail)
+	}
+
+	return converted
+}
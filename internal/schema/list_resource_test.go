@@ -0,0 +1,136 @@
+package schema
+
+import (
+	"context"
+	"testing"
+
+	fwdiag "github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	resourceschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testListedNames = map[string]string{
+	"id-1": "first",
+	"id-2": "second",
+	"id-3": "third",
+}
+
+func testListedResource() *schema.Resource {
+	return WithIDIdentity(&schema.Resource{
+		CreateContext: func(_ context.Context, data *schema.ResourceData, _ interface{}) diag.Diagnostics {
+			data.SetId("id-1")
+			return nil
+		},
+		ReadContext: func(_ context.Context, data *schema.ResourceData, _ interface{}) diag.Diagnostics {
+			name, ok := testListedNames[data.Id()]
+			if !ok {
+				data.SetId("")
+				return nil
+			}
+
+			return diag.FromErr(data.Set("name", name))
+		},
+		DeleteContext: schema.NoopContext,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+		},
+	})
+}
+
+func testListRequest(includeResource bool, limit int64) list.ListRequest {
+	return list.ListRequest{
+		IncludeResource: includeResource,
+		Limit:           limit,
+		ResourceSchema: resourceschema.Schema{
+			Attributes: map[string]resourceschema.Attribute{
+				"id":   resourceschema.StringAttribute{Optional: true, Computed: true},
+				"name": resourceschema.StringAttribute{Optional: true},
+			},
+		},
+		ResourceIdentitySchema: identityschema.Schema{
+			Attributes: map[string]identityschema.Attribute{
+				"id": identityschema.StringAttribute{RequiredForImport: true},
+			},
+		},
+	}
+}
+
+func testListResults(t *testing.T, listResource list.ListResource, req list.ListRequest) []list.ListResult {
+	t.Helper()
+
+	stream := &list.ListResultsStream{}
+	listResource.List(context.Background(), req, stream)
+
+	var results []list.ListResult
+	for result := range stream.Results {
+		results = append(results, result)
+	}
+
+	return results
+}
+
+func TestListResource(t *testing.T) {
+	listResource := NewListResource(ListResourceConfig{
+		TypeName: "_thing",
+		Resource: testListedResource,
+		List: func(_ context.Context, _ tfsdk.Config, _ interface{}, yield func(ListItem) bool) fwdiag.Diagnostics {
+			for _, id := range []string{"id-1", "id-2", "id-gone", "id-3"} {
+				if !yield(ListItem{ID: id, DisplayName: testListedNames[id]}) {
+					return nil
+				}
+			}
+			return nil
+		},
+	})
+	listResource.(list.ListResourceWithConfigure).Configure(
+		context.Background(),
+		resource.ConfigureRequest{ProviderData: "meta"},
+		&resource.ConfigureResponse{},
+	)
+
+	t.Run("it lists the identities", func(t *testing.T) {
+		results := testListResults(t, listResource, testListRequest(false, 0))
+		require.Len(t, results, 4)
+
+		var id types.String
+		require.False(t, results[1].Identity.GetAttribute(context.Background(), path.Root("id"), &id).HasError())
+		assert.Equal(t, "id-2", id.ValueString())
+		assert.Equal(t, "second", results[1].DisplayName)
+		assert.True(t, results[1].Resource.Raw.IsNull())
+	})
+
+	t.Run("it stops at the limit", func(t *testing.T) {
+		results := testListResults(t, listResource, testListRequest(false, 2))
+		assert.Len(t, results, 2)
+	})
+
+	t.Run("it includes the resources and skips deleted ones", func(t *testing.T) {
+		results := testListResults(t, listResource, testListRequest(true, 0))
+		require.Len(t, results, 3)
+
+		var name types.String
+		require.False(t, results[2].Resource.GetAttribute(context.Background(), path.Root("name"), &name).HasError())
+		assert.Equal(t, "third", name.ValueString())
+	})
+
+	t.Run("it fails when the provider is not configured", func(t *testing.T) {
+		results := testListResults(t, NewListResource(ListResourceConfig{}), testListRequest(false, 0))
+		require.Len(t, results, 1)
+		assert.True(t, results[0].Diagnostics.HasError())
+	})
+}
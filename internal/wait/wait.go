@@ -2,26 +2,178 @@
 package wait
 
 import (
+	"context"
 	"fmt"
+	"math/rand/v2"
+	"slices"
 	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	defaultInitialInterval = 500 * time.Millisecond
+	defaultMaxInterval     = 10 * time.Second
+	defaultMultiplier      = 1.5
+	defaultJitter          = 0.2
 )
 
-// Until takes a function which is called every pollingMillis milliseconds until
-// it returns true, it has tried polling more than pollCount times, or there is an error.
-func Until(pollingMillis, pollCount int, pollFunc func() (bool, error)) error {
-	if pollingMillis < 0 {
-		return fmt.Errorf("pollingMillis must not be less than zero")
-	} else if pollCount < 0 {
-		return fmt.Errorf("pollingCount must not be less than zero")
+// randomFloat64 is replaced in tests to make the jitter deterministic.
+var randomFloat64 = rand.Float64
+
+// Options configure how an operation gets polled.
+type Options struct {
+	// Operation describes what is being waited for in logs
+	// and errors, e.g. `action "my-action" to be built`.
+	Operation string
+
+	// Timeout bounds the total time spent polling, usually the timeout of the
+	// resource operation. The context deadline applies when it's zero.
+	Timeout time.Duration
+
+	// InitialInterval is the delay before the second poll. Defaults to 500ms.
+	InitialInterval time.Duration
+
+	// MaxInterval caps the delay between polls. Defaults to 10s.
+	MaxInterval time.Duration
+
+	// Multiplier grows the delay after every poll. Defaults to 1.5.
+	Multiplier float64
+
+	// Jitter randomizes each delay by up to this fraction of it, so that
+	// parallel operations don't poll in lockstep. Defaults to 0.2.
+	Jitter float64
+}
+
+// pollingContextKey marks the context passed to the conditions being polled.
+type pollingContextKey struct{}
+
+// IsPolling checks whether the context is the one of a condition being polled,
+// so that the HTTP clients can skip their caches and read the current state.
+func IsPolling(ctx context.Context) bool {
+	polling, _ := ctx.Value(pollingContextKey{}).(bool)
+	return polling
+}
+
+// Condition polls the operation once. It returns true once the operation
+// reached its target state, along with a status reported in the logs while
+// polling. Any error is terminal and stops polling straight away.
+type Condition func(ctx context.Context) (done bool, status string, err error)
+
+// Until polls the condition with exponential backoff until it's done, it
+// returns an error, or the context is done or the timeout is exceeded.
+func Until(ctx context.Context, options Options, condition Condition) error {
+	options = options.withDefaults()
+
+	if options.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, options.Timeout)
+		defer cancel()
 	}
+	ctx = context.WithValue(ctx, pollingContextKey{}, true)
+
+	fields := map[string]interface{}{"operation": options.Operation}
+	started := time.Now()
+	interval := options.InitialInterval
 
-	for i := 0; i < pollCount; i++ {
-		if condition, err := pollFunc(); err != nil {
+	for attempt := 1; ; attempt++ {
+		done, status, err := condition(ctx)
+		if err != nil {
 			return err
-		} else if condition {
+		}
+
+		fields["attempt"] = attempt
+		fields["status"] = status
+		fields["elapsed"] = time.Since(started).Round(time.Millisecond).String()
+
+		if done {
+			tflog.Debug(ctx, "Finished waiting for the operation", fields)
 			return nil
 		}
-		time.Sleep(time.Duration(pollingMillis) * time.Millisecond)
+
+		delay := options.jitter(interval)
+		fields["next_poll_in"] = delay.Round(time.Millisecond).String()
+		tflog.Debug(ctx, "Waiting for the operation", fields)
+		delete(fields, "next_poll_in")
+
+		if err := sleep(ctx, delay); err != nil {
+			return fmt.Errorf(
+				"stopped waiting for %s after %s, last status %q: %w",
+				options.Operation,
+				time.Since(started).Round(time.Second),
+				status,
+				err,
+			)
+		}
+
+		interval = min(time.Duration(float64(interval)*options.Multiplier), options.MaxInterval)
+	}
+}
+
+// ForState polls the state of an operation until it's one of the target
+// states. Reaching one of the failed states is terminal and returns an error.
+func ForState(
+	ctx context.Context,
+	options Options,
+	state func(ctx context.Context) (string, error),
+	targets []string,
+	failures ...string,
+) error {
+	return Until(ctx, options, func(ctx context.Context) (bool, string, error) {
+		current, err := state(ctx)
+		if err != nil {
+			return false, current, err
+		}
+
+		if slices.Contains(failures, current) {
+			return false, current, fmt.Errorf(
+				"stopped waiting for %s, reached the failed status %q",
+				options.Operation,
+				current,
+			)
+		}
+
+		return slices.Contains(targets, current), current, nil
+	})
+}
+
+func (options Options) withDefaults() Options {
+	if options.InitialInterval <= 0 {
+		options.InitialInterval = defaultInitialInterval
+	}
+	if options.MaxInterval <= 0 {
+		options.MaxInterval = defaultMaxInterval
+	}
+	if options.MaxInterval < options.InitialInterval {
+		options.MaxInterval = options.InitialInterval
+	}
+	if options.Multiplier < 1 {
+		options.Multiplier = defaultMultiplier
+	}
+	if options.Jitter <= 0 || options.Jitter >= 1 {
+		options.Jitter = defaultJitter
+	}
+	if options.Operation == "" {
+		options.Operation = "the operation"
+	}
+
+	return options
+}
+
+// jitter spreads the interval evenly within the jitter fraction around it.
+func (options Options) jitter(interval time.Duration) time.Duration {
+	spread := (2*randomFloat64() - 1) * options.Jitter
+	return time.Duration(float64(interval) * (1 + spread))
+}
+
+func sleep(ctx context.Context, delay time.Duration) error {
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
 	}
-	return fmt.Errorf("timeout after %d milliseconds", pollingMillis*pollCount)
 }
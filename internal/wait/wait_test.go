@@ -1,45 +1,191 @@
 package wait
 
 import (
+	"bytes"
+	"context"
 	"fmt"
 	"testing"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-log/tflogtest"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
+var fastOptions = Options{
+	Operation:       "test operation",
+	InitialInterval: time.Millisecond,
+	MaxInterval:     4 * time.Millisecond,
+}
+
 func TestUntil(t *testing.T) {
-	t.Run("it returns an error when given a negative pollingInterval", func(t *testing.T) {
-		err := Until(-1, 1, func() (bool, error) {
-			return true, nil
+	t.Run("it returns an error when the condition returns an error", func(t *testing.T) {
+		calls := 0
+		err := Until(context.Background(), fastOptions, func(context.Context) (bool, string, error) {
+			calls++
+			return false, "", fmt.Errorf("error foo")
+		})
+		assert.EqualError(t, err, "error foo")
+		assert.Equal(t, 1, calls)
+	})
+
+	t.Run("it returns nil once the condition is done", func(t *testing.T) {
+		calls := 0
+		err := Until(context.Background(), fastOptions, func(context.Context) (bool, string, error) {
+			calls++
+			return calls == 3, "pending", nil
 		})
-		assert.Error(t, err)
+		assert.NoError(t, err)
+		assert.Equal(t, 3, calls)
 	})
 
-	t.Run("it returns an error when given a negative pollingCount", func(t *testing.T) {
-		err := Until(1, -1, func() (bool, error) {
-			return true, nil
+	t.Run("it returns an error when the timeout is exceeded", func(t *testing.T) {
+		options := fastOptions
+		options.Timeout = 20 * time.Millisecond
+
+		err := Until(context.Background(), options, func(context.Context) (bool, string, error) {
+			return false, "pending", nil
 		})
-		assert.Error(t, err)
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+		assert.ErrorContains(t, err, `stopped waiting for test operation after 0s, last status "pending"`)
 	})
 
-	t.Run("it returns an error when pollingFunc returns an error", func(t *testing.T) {
-		err := Until(1, 1, func() (bool, error) {
-			return false, fmt.Errorf("error foo")
+	t.Run("it stops polling when the context is canceled", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+
+		calls := 0
+		err := Until(ctx, fastOptions, func(context.Context) (bool, string, error) {
+			calls++
+			if calls == 2 {
+				cancel()
+			}
+			return false, "pending", nil
 		})
-		assert.ErrorContains(t, err, "error foo")
+		assert.ErrorIs(t, err, context.Canceled)
+		assert.Equal(t, 2, calls)
 	})
 
-	t.Run("it returns an error when pollingFunc never returns true", func(t *testing.T) {
-		err := Until(1, 1, func() (bool, error) {
-			return false, nil
+	t.Run("it passes a context bound by the timeout to the condition", func(t *testing.T) {
+		options := fastOptions
+		options.Timeout = time.Minute
+
+		err := Until(context.Background(), options, func(ctx context.Context) (bool, string, error) {
+			deadline, ok := ctx.Deadline()
+			require.True(t, ok)
+			assert.WithinDuration(t, time.Now().Add(time.Minute), deadline, time.Second)
+			return true, "done", nil
 		})
-		assert.ErrorContains(t, err, "timeout")
+		assert.NoError(t, err)
 	})
 
-	t.Run("it returns nil when pollingFunc returns true", func(t *testing.T) {
-		err := Until(1, 1, func() (bool, error) {
-			return true, nil
+	t.Run("it marks the context passed to the condition as polling", func(t *testing.T) {
+		assert.False(t, IsPolling(context.Background()))
+
+		err := Until(context.Background(), fastOptions, func(ctx context.Context) (bool, string, error) {
+			assert.True(t, IsPolling(ctx))
+			return true, "done", nil
 		})
 		assert.NoError(t, err)
 	})
 }
+
+func TestUntilLogging(t *testing.T) {
+	stubRandomFloat64(t, 0.5)
+
+	var output bytes.Buffer
+	ctx := tflogtest.RootLogger(context.Background(), &output)
+
+	calls := 0
+	err := Until(ctx, fastOptions, func(context.Context) (bool, string, error) {
+		calls++
+		if calls == 2 {
+			return true, "built", nil
+		}
+		return false, "building", nil
+	})
+	require.NoError(t, err)
+
+	entries, err := tflogtest.MultilineJSONDecode(&output)
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+
+	assert.Equal(t, "Waiting for the operation", entries[0]["@message"])
+	assert.Equal(t, "test operation", entries[0]["operation"])
+	assert.Equal(t, "building", entries[0]["status"])
+	assert.Equal(t, float64(1), entries[0]["attempt"])
+	assert.Equal(t, "1ms", entries[0]["next_poll_in"])
+
+	assert.Equal(t, "Finished waiting for the operation", entries[1]["@message"])
+	assert.Equal(t, "built", entries[1]["status"])
+	assert.Equal(t, float64(2), entries[1]["attempt"])
+	assert.NotContains(t, entries[1], "next_poll_in")
+}
+
+func TestForState(t *testing.T) {
+	t.Run("it returns nil once a target state is reached", func(t *testing.T) {
+		states := []string{"pending", "building", "built"}
+		err := ForState(context.Background(), fastOptions, func(context.Context) (string, error) {
+			state := states[0]
+			states = states[1:]
+			return state, nil
+		}, []string{"built"}, "failed")
+		assert.NoError(t, err)
+		assert.Empty(t, states)
+	})
+
+	t.Run("it returns an error once a failed state is reached", func(t *testing.T) {
+		states := []string{"building", "failed", "built"}
+		err := ForState(context.Background(), fastOptions, func(context.Context) (string, error) {
+			state := states[0]
+			states = states[1:]
+			return state, nil
+		}, []string{"built"}, "failed")
+		assert.EqualError(t, err, `stopped waiting for test operation, reached the failed status "failed"`)
+		assert.Equal(t, []string{"built"}, states)
+	})
+
+	t.Run("it returns an error when reading the state fails", func(t *testing.T) {
+		err := ForState(context.Background(), fastOptions, func(context.Context) (string, error) {
+			return "", fmt.Errorf("error foo")
+		}, []string{"built"})
+		assert.EqualError(t, err, "error foo")
+	})
+}
+
+func TestOptions(t *testing.T) {
+	t.Run("it applies the defaults", func(t *testing.T) {
+		assert.Equal(t, Options{
+			Operation:       "the operation",
+			InitialInterval: defaultInitialInterval,
+			MaxInterval:     defaultMaxInterval,
+			Multiplier:      defaultMultiplier,
+			Jitter:          defaultJitter,
+		}, Options{}.withDefaults())
+	})
+
+	t.Run("it keeps the max interval above the initial one", func(t *testing.T) {
+		options := Options{InitialInterval: time.Minute}.withDefaults()
+		assert.Equal(t, time.Minute, options.MaxInterval)
+	})
+
+	t.Run("it spreads the interval within the jitter", func(t *testing.T) {
+		options := Options{Jitter: 0.5}.withDefaults()
+
+		stubRandomFloat64(t, 0)
+		assert.Equal(t, 5*time.Second, options.jitter(10*time.Second))
+
+		stubRandomFloat64(t, 0.5)
+		assert.Equal(t, 10*time.Second, options.jitter(10*time.Second))
+
+		stubRandomFloat64(t, 0.999999)
+		assert.InDelta(t, 15*time.Second, options.jitter(10*time.Second), float64(time.Millisecond))
+	})
+}
+
+func stubRandomFloat64(t *testing.T, value float64) {
+	t.Helper()
+
+	original := randomFloat64
+	randomFloat64 = func() float64 { return value }
+	t.Cleanup(func() { randomFloat64 = original })
+}
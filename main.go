@@ -1,12 +1,16 @@
 package main
 
 import (
+	"context"
+	"log"
 	"os"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5/tf5server"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/plugin"
 
+	"github.com/auth0/terraform-provider-auth0/internal/config"
+	"github.com/auth0/terraform-provider-auth0/internal/export"
 	"github.com/auth0/terraform-provider-auth0/internal/provider"
 )
 
@@ -27,8 +31,33 @@ func main() {
 		debug = debugEnv
 	}
 
-	plugin.Serve(&plugin.ServeOpts{
-		ProviderFunc: provider.New,
-		Debug:        debug,
-	})
+	providerServer, err := provider.NewMuxServer(context.Background(), provider.New())
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if len(os.Args) > 1 && os.Args[1] == "export" {
+		if err := export.Command(context.Background(), providerServer(), os.Args[2:], os.Stdout); err != nil {
+			log.Fatal(err)
+		}
+
+		if err := config.WriteAPIUsageReport(); err != nil {
+			log.Printf("[ERROR] %s", err)
+		}
+		return
+	}
+
+	var serveOpts []tf5server.ServeOpt
+	if debug {
+		serveOpts = append(serveOpts, tf5server.WithManagedDebug())
+	}
+
+	if err := tf5server.Serve("registry.terraform.io/auth0/auth0", providerServer, serveOpts...); err != nil {
+		log.Fatal(err)
+	}
+
+	// Serve returns once Terraform shuts the provider down.
+	if err := config.WriteAPIUsageReport(); err != nil {
+		log.Printf("[ERROR] %s", err)
+	}
 }